@@ -0,0 +1,70 @@
+package thbtextizer
+
+import (
+	"sync"
+)
+
+// Baht is an amount that prints as its Thai reading, so it can be used
+// directly with fmt verbs and text/template:
+//
+//	b, _ := NewBaht("1500")
+//	fmt.Printf("%v", b) // หนึ่งพันห้าร้อยบาทถ้วน
+//
+// The reading is computed on first use and cached. Baht implements
+// encoding.TextMarshaler and encoding.TextUnmarshaler, so it round-trips
+// through JSON as the Thai string. The zero value reads as zero baht.
+type Baht struct {
+	amount normalizedAmount
+	cache  *bahtCache
+}
+
+type bahtCache struct {
+	once sync.Once
+	text string
+}
+
+// NewBaht validates and rounds amount to satang using RoundHalf
+func NewBaht(amount any) (Baht, error) {
+	n, err := prepareAmount(amount, RoundHalf, convertOptions{})
+	if err != nil {
+		return Baht{}, err
+	}
+	return Baht{amount: n, cache: &bahtCache{}}, nil
+}
+
+// String returns the Thai reading of b
+func (b Baht) String() string {
+	if b.cache == nil {
+		return renderAmount(b.amount, convertOptions{})
+	}
+	b.cache.once.Do(func() {
+		b.cache.text = renderAmount(b.amount, convertOptions{})
+	})
+	return b.cache.text
+}
+
+// Amount returns b as a decimal string with two satang digits, e.g. "123.45"
+func (b Baht) Amount() string {
+	return b.amount.decimalString()
+}
+
+// MarshalText encodes b as its Thai reading
+func (b Baht) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText decodes a Thai reading produced by MarshalText. Plain numeric
+// text such as "123.45" is accepted too.
+func (b *Baht) UnmarshalText(text []byte) error {
+	amount, err := parseThaiAmount(string(text))
+	if err != nil {
+		amount = string(text)
+	}
+
+	parsed, err := NewBaht(amount)
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
@@ -0,0 +1,102 @@
+package thbtextizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestBahtString(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+		amount   string
+	}{
+		{"1500", "หนึ่งพันห้าร้อยบาทถ้วน", "1500.00"},
+		{123.456, "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์", "123.46"},
+		{"-0.5", "ลบศูนย์บาทห้าสิบสตางค์", "-0.50"},
+		{"1,000,000.01", "หนึ่งล้านบาทหนึ่งสตางค์", "1000000.01"},
+	}
+
+	for _, test := range tests {
+		b, err := NewBaht(test.input)
+		if err != nil {
+			t.Errorf("NewBaht(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result := fmt.Sprintf("%v", b); result != test.expected {
+			t.Errorf("fmt %%v of NewBaht(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+		// The second call is served from the cache
+		if result := b.String(); result != test.expected {
+			t.Errorf("NewBaht(%v).String() = %s, expected %s", test.input, result, test.expected)
+		}
+		if result := b.Amount(); result != test.amount {
+			t.Errorf("NewBaht(%v).Amount() = %s, expected %s", test.input, result, test.amount)
+		}
+	}
+
+	if _, err := NewBaht("abc"); err == nil {
+		t.Errorf("NewBaht(abc) expected error, got nil")
+	}
+
+	var zero Baht
+	if result := zero.String(); result != "ศูนย์บาทถ้วน" {
+		t.Errorf("Baht{}.String() = %s, expected ศูนย์บาทถ้วน", result)
+	}
+}
+
+func TestBahtTemplate(t *testing.T) {
+	b, _ := NewBaht(21)
+	tmpl := template.Must(template.New("receipt").Parse("รวม {{.}}"))
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, b); err != nil {
+		t.Fatalf("template execution returned error: %v", err)
+	}
+	if expected := "รวม ยี่สิบเอ็ดบาทถ้วน"; out.String() != expected {
+		t.Errorf("template output = %s, expected %s", out.String(), expected)
+	}
+}
+
+func TestBahtJSONRoundTrip(t *testing.T) {
+	type receipt struct {
+		Total Baht `json:"total"`
+	}
+
+	for _, input := range []string{"0", "100", "123.45", "-21.01", "11000011000000", "9223372036854775807.99"} {
+		b, err := NewBaht(input)
+		if err != nil {
+			t.Errorf("NewBaht(%s) returned error: %v", input, err)
+			continue
+		}
+
+		data, err := json.Marshal(receipt{Total: b})
+		if err != nil {
+			t.Errorf("json.Marshal(%s) returned error: %v", input, err)
+			continue
+		}
+		if expected := fmt.Sprintf(`{"total":%q}`, b.String()); string(data) != expected {
+			t.Errorf("json.Marshal(%s) = %s, expected %s", input, data, expected)
+		}
+
+		var decoded receipt
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", data, err)
+			continue
+		}
+		if decoded.Total.Amount() != b.Amount() {
+			t.Errorf("round trip of %s = %s, expected %s", input, decoded.Total.Amount(), b.Amount())
+		}
+	}
+
+	var b Baht
+	if err := b.UnmarshalText([]byte("42.5")); err != nil || b.Amount() != "42.50" {
+		t.Errorf("UnmarshalText(42.5) = %s, %v, expected 42.50", b.Amount(), err)
+	}
+	if err := b.UnmarshalText([]byte("ไม่ใช่จำนวนเงิน")); err == nil {
+		t.Errorf("UnmarshalText of invalid text expected error, got nil")
+	}
+}
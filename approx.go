@@ -0,0 +1,96 @@
+package thbtextizer
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ConvertApprox reads only the top significantGroups six-digit groups of
+// amount and appends "เศษ" when anything below them is non-zero, for
+// approximate spoken summaries. Satang counts as part of the lowest group, so
+// it is only dropped along with that group. "เศษ" takes the place of the
+// satang words, before any Suffix, and LegalNumerals show the exact amount:
+//
+//	ConvertApprox(1234567, 1) -> "หนึ่งล้านบาทเศษ"
+//	ConvertApprox(1000000, 1) -> "หนึ่งล้านบาทถ้วน"
+func ConvertApprox(amount any, significantGroups int, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertApprox(amount, significantGroups, mode, globalOptions())
+}
+
+// ConvertApprox reads the top groups of amount using instance configuration
+func (c *Converter) ConvertApprox(amount any, significantGroups int, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertApprox(amount, significantGroups, mode, c.config.options())
+}
+
+func convertApprox(amount any, significantGroups int, mode DecimalRoundingMode, opts convertOptions) (string, error) {
+	if significantGroups < 1 {
+		err := newInvalidInputError(strconv.Itoa(significantGroups), reasonSignificantGroups)
+		return "", localizeError(err, opts.errorLanguage)
+	}
+
+	n, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return "", err
+	}
+
+	groups := splitGroups(strings.TrimLeft(n.integer, "0"))
+	dropped := len(groups) > significantGroups && strings.Trim(n.decimal, "0") != ""
+	for i := significantGroups; i < len(groups); i++ {
+		if strings.Trim(groups[i], "0") != "" {
+			dropped = true
+		}
+		groups[i] = strings.Repeat("0", len(groups[i]))
+	}
+	if !dropped {
+		return renderAmount(n, opts), nil
+	}
+
+	exact := n
+	n.integer = strings.Join(groups, "")
+	n.decimal = ""
+	if n.isZero() {
+		n.negative = false
+	}
+
+	// "เศษ" takes the place of the satang, inside any suffix and
+	// parentheses, and legal numerals still show the exact amount
+	numerals := opts.legalNumerals
+	opts.approximate = true
+	opts.legalNumerals = false
+
+	var builder strings.Builder
+	out := &errWriter{w: &builder}
+	out.writeString(renderAmount(n, opts))
+	if numerals {
+		writeNumerals(out, readAmount(exact, opts))
+	}
+	return builder.String(), nil
+}
+
+// splitGroups splits integer digits into six-digit ล้าน groups, most
+// significant first; only the first group can be shorter than six digits
+func splitGroups(digits string) []string {
+	if digits == "" {
+		return nil
+	}
+
+	lead := len(digits) % 6
+	if lead == 0 {
+		lead = 6
+	}
+	groups := []string{digits[:lead]}
+	for i := lead; i < len(digits); i += 6 {
+		groups = append(groups, digits[i:i+6])
+	}
+	return groups
+}
@@ -0,0 +1,92 @@
+package thbtextizer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConvertApprox(t *testing.T) {
+	tests := []struct {
+		input    any
+		groups   int
+		expected string
+	}{
+		{1234567, 1, "หนึ่งล้านบาทเศษ"},
+		{1234567, 2, "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทถ้วน"},
+		{"1234567.50", 2, "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทห้าสิบสตางค์"},
+		{1000000, 1, "หนึ่งล้านบาทถ้วน"},
+		{"1000000.25", 1, "หนึ่งล้านบาทเศษ"},
+		{"2000001000000", 1, "สองล้านล้านบาทเศษ"},
+		{"0.75", 1, "ศูนย์บาทเจ็ดสิบห้าสตางค์"},
+		{"-1234567", 1, "ลบหนึ่งล้านบาทเศษ"},
+		{"123.45", 1, "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertApprox(test.input, test.groups)
+		if err != nil {
+			t.Errorf("ConvertApprox(%v, %d) returned error: %v", test.input, test.groups, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertApprox(%v, %d) = %s, expected %s", test.input, test.groups, result, test.expected)
+		}
+	}
+
+	if _, err := ConvertApprox(1, 0); err == nil {
+		t.Errorf("ConvertApprox(1, 0) expected error, got nil")
+	}
+
+	converter := NewConverter(&Config{Suffix: " โดยประมาณ"})
+	result, _ := converter.ConvertApprox(1234567, 1)
+	if expected := "หนึ่งล้านบาทเศษ โดยประมาณ"; result != expected {
+		t.Errorf("ConvertApprox(1234567, 1) with Suffix = %s, expected %s", result, expected)
+	}
+
+	_, err := ConvertApprox(1, -3)
+	if convErr, ok := err.(*ConversionError); !ok || convErr.Input != "-3" {
+		t.Errorf("ConvertApprox(1, -3) error = %v, expected input -3", err)
+	}
+}
+
+func TestConvertApproxOptions(t *testing.T) {
+	tests := []struct {
+		config   *Config
+		expected string
+	}{
+		{&Config{NegativeStyle: NegativeParentheses, LegalNumerals: true}, "(หนึ่งล้านบาทเศษ) (-1,234,567.00)"},
+		{&Config{NegativeStyle: NegativeParentheses, Suffix: " โดยประมาณ"}, "(หนึ่งล้านบาทเศษ โดยประมาณ)"},
+		{&Config{SatangAsFraction: true, FractionForEven: true}, "ลบหนึ่งล้านบาทเศษ"},
+		{&Config{ZeroSatangStyle: ExplicitZeroSatang}, "ลบหนึ่งล้านบาทเศษ"},
+	}
+
+	for _, test := range tests {
+		result, err := NewConverter(test.config).ConvertApprox("-1234567", 1)
+		if err != nil {
+			t.Errorf("ConvertApprox(-1234567, 1) with %+v returned error: %v", *test.config, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertApprox(-1234567, 1) with %+v = %s, expected %s", *test.config, result, test.expected)
+		}
+	}
+}
+
+func TestSplitGroups(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"123", []string{"123"}},
+		{"123456", []string{"123456"}},
+		{"1234567", []string{"1", "234567"}},
+		{"9223372036854775807", []string{"9", "223372", "036854", "775807"}},
+	}
+
+	for _, test := range tests {
+		if result := splitGroups(test.input); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("splitGroups(%s) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package thbtextizer
+
+import (
+	"strings"
+)
+
+// ConvertAll converts each amount and returns the readings and errors at the
+// matching indexes, so one bad value does not abort the batch. All readings
+// are written into a single shared buffer and sliced out of it, which saves
+// an allocation per amount.
+func ConvertAll(amounts []any, roundingMode ...DecimalRoundingMode) ([]string, []error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertAll(amounts, mode, globalOptions())
+}
+
+// ConvertAll converts each amount using instance configuration
+func (c *Converter) ConvertAll(amounts []any, roundingMode ...DecimalRoundingMode) ([]string, []error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertAll(amounts, mode, c.config.options())
+}
+
+func convertAll(amounts []any, mode DecimalRoundingMode, opts convertOptions) ([]string, []error) {
+	results := make([]string, len(amounts))
+	errs := make([]error, len(amounts))
+	ends := make([]int, len(amounts))
+
+	var builder strings.Builder
+	builder.Grow(len(amounts) * 128)
+
+	for i, amount := range amounts {
+		normalized, err := prepareAmount(amount, mode, opts)
+		if err != nil {
+			errs[i] = err
+		} else {
+			// strings.Builder never returns write errors
+			_ = writeReading(&builder, readAmount(normalized, opts), opts.units(), opts)
+		}
+		ends[i] = builder.Len()
+	}
+
+	all := builder.String()
+	start := 0
+	for i, end := range ends {
+		results[i] = all[start:end]
+		start = end
+	}
+
+	return results, errs
+}
@@ -0,0 +1,41 @@
+package thbtextizer
+
+import (
+	"testing"
+)
+
+func TestConvertAll(t *testing.T) {
+	amounts := []any{"123.45", "abc", 100, "-21", nil, 0.5}
+
+	results, errs := ConvertAll(amounts)
+	if len(results) != len(amounts) || len(errs) != len(amounts) {
+		t.Fatalf("ConvertAll returned %d results and %d errors, expected %d of each", len(results), len(errs), len(amounts))
+	}
+
+	for i, amount := range amounts {
+		expected, expectedErr := Convert(amount)
+		if (errs[i] != nil) != (expectedErr != nil) {
+			t.Errorf("ConvertAll[%d] (%v) error = %v, expected %v", i, amount, errs[i], expectedErr)
+		}
+		if results[i] != expected {
+			t.Errorf("ConvertAll[%d] (%v) = %s, expected %s", i, amount, results[i], expected)
+		}
+	}
+
+	converter := NewConverter(&Config{DefaultRounding: RoundDown})
+	results, errs = converter.ConvertAll([]any{"1.999", "2.001"})
+	expected := []string{"หนึ่งบาทเก้าสิบเก้าสตางค์", "สองบาทถ้วน"}
+	for i := range expected {
+		if errs[i] != nil {
+			t.Errorf("ConvertAll[%d] returned error: %v", i, errs[i])
+		}
+		if results[i] != expected[i] {
+			t.Errorf("ConvertAll[%d] = %s, expected %s", i, results[i], expected[i])
+		}
+	}
+
+	results, errs = ConvertAll(nil)
+	if len(results) != 0 || len(errs) != 0 {
+		t.Errorf("ConvertAll(nil) = %v, %v, expected empty slices", results, errs)
+	}
+}
@@ -0,0 +1,113 @@
+package thbtextizer
+
+import (
+	"container/list"
+	"sync"
+)
+
+// NewCachingConverter creates a converter whose Convert method remembers the
+// readings of the size most recently converted amounts, for workloads such
+// as ledger exports where the same amounts recur. Amounts are cached by
+// their value after rounding, so "100", "100.00" and 100.001 share an entry;
+// other methods are not cached. A size below 1 disables the cache. The
+// converter is safe for concurrent use.
+func NewCachingConverter(config *Config, size int) *Converter {
+	converter := NewConverter(config)
+	if size > 0 {
+		converter.cache = newLRUCache(size)
+	}
+	return converter
+}
+
+// Reset drops every cached reading to free its memory, for example between
+// large jobs; the cache refills as amounts are converted again. Converters
+// without a cache have nothing to drop. Reset must not be called while c is
+// converting on another goroutine.
+func (c *Converter) Reset() {
+	if c.cache != nil {
+		c.cache.clear()
+	}
+}
+
+// convertCached converts amount with the instance settings, reusing the
+// cached reading of an equal rounded amount
+func (c *Converter) convertCached(amount any, roundingMode []DecimalRoundingMode) (string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	opts := c.config.options()
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return "", err
+	}
+
+	key := normalized.decimalString()
+	if text, ok := c.cache.get(key); ok {
+		return text, nil
+	}
+
+	text := renderAmount(normalized, opts)
+	c.cache.add(key, text)
+	return text, nil
+}
+
+// lruCache is a fixed-size map from normalized amounts to readings that
+// evicts the least recently used entry when full
+type lruCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // front is the most recently used
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key  string
+	text string
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+func (l *lruCache) get(key string) (string, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.entries[key]
+	if !ok {
+		return "", false
+	}
+	l.order.MoveToFront(element)
+	return element.Value.(*lruEntry).text, true
+}
+
+func (l *lruCache) clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.order.Init()
+	l.entries = make(map[string]*list.Element, l.size)
+}
+
+func (l *lruCache) add(key, text string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.entries[key]; ok {
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, text: text})
+	if l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+}
@@ -0,0 +1,140 @@
+package thbtextizer
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestCachingConverter(t *testing.T) {
+	config := &Config{DefaultRounding: RoundHalf, Suffix: " (ชำระแล้ว)"}
+	cached := NewCachingConverter(config, 4)
+	uncached := NewConverter(config)
+
+	inputs := []any{"100", "100.00", 100.001, "-21.5", "1,234.56", "0", "9223372036854775807", "100", "-21.50", "0.999"}
+	for _, input := range inputs {
+		for range 2 {
+			result, err := cached.Convert(input)
+			expected, _ := uncached.Convert(input)
+			if err != nil {
+				t.Errorf("Convert(%v) with cache returned error: %v", input, err)
+				continue
+			}
+			if result != expected {
+				t.Errorf("Convert(%v) with cache = %s, expected %s", input, result, expected)
+			}
+		}
+	}
+
+	// The rounding mode is applied before the cache lookup
+	down, _ := cached.Convert("1.239", RoundDown)
+	up, _ := cached.Convert("1.231", RoundUp)
+	if down != "หนึ่งบาทยี่สิบสามสตางค์ (ชำระแล้ว)" || up != "หนึ่งบาทยี่สิบสี่สตางค์ (ชำระแล้ว)" {
+		t.Errorf("Convert with rounding modes and cache = %s, %s", down, up)
+	}
+
+	if cached.cache.order.Len() != 4 || len(cached.cache.entries) != 4 {
+		t.Errorf("cache holds %d entries, expected 4", cached.cache.order.Len())
+	}
+
+	if _, err := cached.Convert("abc"); err == nil {
+		t.Error("Convert(abc) with cache expected error, got nil")
+	}
+
+	// Equal rounded amounts share one entry
+	shared := NewCachingConverter(nil, 4)
+	for _, input := range []any{"100", "100.00", 100.001, 100} {
+		if _, err := shared.Convert(input); err != nil {
+			t.Errorf("Convert(%v) with cache returned error: %v", input, err)
+		}
+	}
+	if _, ok := shared.cache.entries["100.00"]; !ok || shared.cache.order.Len() != 1 {
+		t.Errorf("cache holds %d entries for equal amounts, expected one keyed 100.00", shared.cache.order.Len())
+	}
+
+	if NewCachingConverter(nil, 0).cache != nil {
+		t.Error("NewCachingConverter with size 0 should not cache")
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	cache := newLRUCache(2)
+	cache.add("1", "a")
+	cache.add("2", "b")
+	cache.get("1")
+	cache.add("3", "c")
+
+	if _, ok := cache.get("2"); ok {
+		t.Error("least recently used entry 2 was not evicted")
+	}
+	for key, expected := range map[string]string{"1": "a", "3": "c"} {
+		if text, ok := cache.get(key); !ok || text != expected {
+			t.Errorf("cache.get(%s) = %s, %v, expected %s, true", key, text, ok, expected)
+		}
+	}
+}
+
+func TestCachingConverterConcurrent(t *testing.T) {
+	converter := NewCachingConverter(nil, 8)
+
+	var wg sync.WaitGroup
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				amount := fmt.Sprintf("%d.%02d", (i+j)%20, j%100)
+				result, err := converter.Convert(amount)
+				expected, _ := Convert(amount)
+				if err != nil || result != expected {
+					t.Errorf("Convert(%s) with cache = %s, %v, expected %s", amount, result, err, expected)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestConverterReset(t *testing.T) {
+	converter := NewCachingConverter(nil, 4)
+	for _, input := range []string{"1", "2", "3"} {
+		converter.Convert(input)
+	}
+
+	converter.Reset()
+	if converter.cache.order.Len() != 0 || len(converter.cache.entries) != 0 {
+		t.Errorf("cache holds %d entries after Reset, expected 0", converter.cache.order.Len())
+	}
+	if result, _ := converter.Convert("1"); result != "หนึ่งบาทถ้วน" || converter.cache.order.Len() != 1 {
+		t.Errorf("Convert(1) after Reset = %s with %d cached entries", result, converter.cache.order.Len())
+	}
+
+	// Converters without a cache have nothing to reset
+	NewDefaultConverter().Reset()
+}
+
+func TestConverterSetConfig(t *testing.T) {
+	converter := NewCachingConverter(nil, 4)
+	if result, _ := converter.Convert("100"); result != "หนึ่งร้อยบาทถ้วน" {
+		t.Fatalf("Convert(100) = %s", result)
+	}
+
+	// The cached reading was made with the old configuration
+	converter.SetConfig(&Config{Suffix: " (ชำระแล้ว)"})
+	if result, _ := converter.Convert("100"); result != "หนึ่งร้อยบาทถ้วน (ชำระแล้ว)" {
+		t.Errorf("Convert(100) after SetConfig = %s", result)
+	}
+
+	converter.SetConfig(nil)
+	if result, _ := converter.Convert("1.005"); result != "หนึ่งบาทหนึ่งสตางค์" {
+		t.Errorf("Convert(1.005) after SetConfig(nil) = %s, expected the default config", result)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SetConfig with an invalid MaxValue expected panic")
+		}
+	}()
+	converter.SetConfig(&Config{MaxValue: "abc"})
+}
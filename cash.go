@@ -0,0 +1,81 @@
+package thbtextizer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// applyCashRounding rounds the magnitude of a normalized amount to a multiple
+// of increment (in baht) using the direction of mode. Ties under RoundHalf
+// round away from zero and under RoundHalfUp toward +∞. The exact sanitized
+// input is rounded rather than the satang value, so "10.0499" rounds to
+// 10.00 in steps of 0.10 instead of going through 10.05.
+func applyCashRounding(n normalizedAmount, increment string, mode DecimalRoundingMode) (normalizedAmount, error) {
+	step, err := cashIncrement(increment)
+	if err != nil {
+		return n, err
+	}
+
+	// Scale the exact value and the step to the same number of decimals,
+	// at least satang
+	integerPart, fractionPart, _ := strings.Cut(n.input, ".")
+	if len(fractionPart) < 2 {
+		fractionPart += strings.Repeat("0", 2-len(fractionPart))
+	}
+	total, ok := new(big.Int).SetString(integerPart+fractionPart, 10)
+	if !ok {
+		return n, newInvalidInputError(n.input, reasonCannotParse)
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fractionPart)-2)), nil)
+	step.Mul(step, scale)
+
+	remainder := new(big.Int).Mod(total, step)
+	total.Sub(total, remainder)
+	if remainder.Sign() > 0 {
+		switch mode.forMagnitude(n.negative) {
+		case RoundUp:
+			total.Add(total, step)
+		case RoundHalf:
+			if new(big.Int).Lsh(remainder, 1).Cmp(step) >= 0 {
+				total.Add(total, step)
+			}
+		case roundHalfDown:
+			if new(big.Int).Lsh(remainder, 1).Cmp(step) > 0 {
+				total.Add(total, step)
+			}
+		}
+	}
+	total.Quo(total, scale)
+
+	baht, satang := new(big.Int).QuoRem(total, big.NewInt(100), new(big.Int))
+	if err := validateMaxValue(baht.String()); err != nil {
+		return n, err
+	}
+
+	n.integer = baht.String()
+	n.decimal = fmt.Sprintf("%02d", satang.Int64())
+	n.capped = false // the exact value was rounded, so no satang were capped
+	if n.isZero() {
+		n.negative = false
+	}
+
+	return n, nil
+}
+
+// cashIncrement parses a Config.CashRounding increment in baht into satang,
+// rejecting increments that are not a positive whole number of satang
+func cashIncrement(increment string) (*big.Int, error) {
+	if parts := strings.Split(increment, "."); len(parts) > 1 && len(parts[1]) > 2 {
+		return nil, newInvalidInputError(increment, reasonCashIncrementPlaces)
+	}
+
+	step, err := amountToSatang(increment, RoundDown, globalOptions())
+	if err != nil {
+		return nil, err
+	}
+	if step.Sign() <= 0 {
+		return nil, newInvalidInputError(increment, reasonCashIncrementPositive)
+	}
+	return step, nil
+}
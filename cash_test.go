@@ -0,0 +1,138 @@
+package thbtextizer
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCashRounding(t *testing.T) {
+	tests := []struct {
+		input     string
+		increment string
+		mode      DecimalRoundingMode
+		expected  string
+	}{
+		{"10.13", "0.25", RoundHalf, "สิบบาทยี่สิบห้าสตางค์"},
+		{"10.13", "0.25", RoundDown, "สิบบาทถ้วน"},
+		{"10.13", "0.25", RoundUp, "สิบบาทยี่สิบห้าสตางค์"},
+		{"10.12", "0.25", RoundHalf, "สิบบาทถ้วน"},
+		{"10.13", "0.50", RoundHalf, "สิบบาทถ้วน"},
+		{"10.13", "0.50", RoundUp, "สิบบาทห้าสิบสตางค์"},
+		{"10.25", "0.50", RoundHalf, "สิบบาทห้าสิบสตางค์"}, // tie rounds up
+		{"10.80", "0.25", RoundHalf, "สิบบาทเจ็ดสิบห้าสตางค์"},
+		{"10.90", "0.25", RoundUp, "สิบเอ็ดบาทถ้วน"},
+		{"17", "5", RoundHalf, "สิบห้าบาทถ้วน"},
+		{"18", "5", RoundHalf, "ยี่สิบบาทถ้วน"},
+		// The exact value is rounded, not its satang rounding
+		{"10.0499", "0.10", RoundHalf, "สิบบาทถ้วน"},
+		{"10.05", "0.10", RoundHalf, "สิบบาทสิบสตางค์"},
+		{"-10.0499", "0.10", RoundHalf, "ลบสิบบาทถ้วน"},
+		{"10.2501", "0.50", RoundHalf, "สิบบาทห้าสิบสตางค์"},
+		{"10.001", "0.25", RoundUp, "สิบบาทยี่สิบห้าสตางค์"},
+		{"9.999", "0.05", RoundHalf, "สิบบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{CashRounding: test.increment})
+		result, err := converter.Convert(test.input, test.mode)
+		if err != nil {
+			t.Errorf("Convert(%s) with CashRounding %s returned error: %v", test.input, test.increment, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s, %v) with CashRounding %s = %s, expected %s", test.input, test.mode, test.increment, result, test.expected)
+		}
+	}
+
+	// Electronic amounts without CashRounding stay exact
+	result, err := NewConverter(&Config{}).Convert("10.13")
+	if err != nil {
+		t.Errorf("Convert(10.13) returned error: %v", err)
+	}
+	if expected := "สิบบาทสิบสามสตางค์"; result != expected {
+		t.Errorf("Convert(10.13) = %s, expected %s", result, expected)
+	}
+
+	for _, config := range []*Config{
+		{CashRounding: "0"},
+		{CashRounding: "-0.25"},
+		{CashRounding: "0.125"},
+		{CashRounding: "abc"},
+		{CashRounding: "0.25", MinorUnitDigits: 3},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewConverter with CashRounding %q and MinorUnitDigits %d expected panic", config.CashRounding, config.MinorUnitDigits)
+				}
+			}()
+			NewConverter(config)
+		}()
+	}
+}
+
+func TestCashRoundingCeilFloor(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{"1.10", RoundCeil, "หนึ่งบาทยี่สิบห้าสตางค์"},
+		{"1.10", RoundFloor, "หนึ่งบาทถ้วน"},
+		{"-1.10", RoundCeil, "ลบหนึ่งบาทถ้วน"},
+		{"-1.10", RoundFloor, "ลบหนึ่งบาทยี่สิบห้าสตางค์"},
+		{"1.125", RoundHalfUp, "หนึ่งบาทยี่สิบห้าสตางค์"},
+		{"-1.125", RoundHalfUp, "ลบหนึ่งบาทถ้วน"},
+		{"-1.125", RoundHalf, "ลบหนึ่งบาทยี่สิบห้าสตางค์"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{CashRounding: "0.25", DefaultRounding: test.mode})
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s, %d) returned error: %v", test.input, test.mode, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s, %d) = %s, expected %s", test.input, test.mode, result, test.expected)
+		}
+	}
+}
+
+func TestCashRoundingMaxValue(t *testing.T) {
+	var original, rounded string
+	converter := NewConverter(&Config{
+		MaxValue:     "99.90",
+		CashRounding: "0.25",
+		OnRound:      func(o, r string) { original, rounded = o, r },
+	})
+
+	_, err := converter.Convert("99.90")
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Code != ErrorCodeExceedsMaxValue {
+		t.Errorf("Convert(99.90) rounding to 100.00 over MaxValue 99.90 = %v, expected ErrorCodeExceedsMaxValue", err)
+	}
+
+	// OnRound reports the cash amount that is read, not the satang rounding
+	result, err := converter.Convert("99.751")
+	if err != nil {
+		t.Fatalf("Convert(99.751) returned error: %v", err)
+	}
+	if expected := "เก้าสิบเก้าบาทเจ็ดสิบห้าสตางค์"; result != expected {
+		t.Errorf("Convert(99.751) = %s, expected %s", result, expected)
+	}
+	if original != "99.751" || rounded != "99.75" {
+		t.Errorf("OnRound(%q, %q), expected (\"99.751\", \"99.75\")", original, rounded)
+	}
+
+	original, rounded = "", ""
+	if _, err := NewConverter(&Config{
+		CashRounding: "0.25",
+		OnRound:      func(o, r string) { original, rounded = o, r },
+	}).Convert("99.901"); err != nil {
+		t.Fatalf("Convert(99.901) returned error: %v", err)
+	}
+	if original != "99.901" || rounded != "100.00" {
+		t.Errorf("OnRound(%q, %q), expected (\"99.901\", \"100.00\")", original, rounded)
+	}
+}
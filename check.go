@@ -0,0 +1,60 @@
+package thbtextizer
+
+// CheckDigit returns the verification digit read out by ConvertWithCheck.
+// It is the sum of every digit of the normalized amount, baht digits followed
+// by the two satang digits, modulo 9. For example 123.45 sums to 15, giving 6.
+func CheckDigit(amount any, roundingMode ...DecimalRoundingMode) (int, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	normalized, err := normalizeAmount(amount, mode, globalOptions())
+	if err != nil {
+		return 0, err
+	}
+
+	return checkDigit(normalized.integer + normalized.decimal), nil
+}
+
+func checkDigit(digits string) int {
+	sum := 0
+	for _, char := range digits {
+		sum += int(char - '0')
+	}
+	return sum % 9
+}
+
+// ConvertWithCheck converts amount and appends the spoken check digit for
+// verbal confirmation, e.g. "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์ รหัสตรวจสอบ หก".
+// See CheckDigit for the algorithm.
+func ConvertWithCheck(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertWithCheck(amount, mode, globalOptions())
+}
+
+// ConvertWithCheck converts amount and appends the spoken check digit using
+// instance configuration, so the digit is read with the instance words
+func (c *Converter) ConvertWithCheck(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertWithCheck(amount, mode, c.config.options())
+}
+
+func convertWithCheck(amount any, mode DecimalRoundingMode, opts convertOptions) (string, error) {
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return "", err
+	}
+
+	lex := opts.words()
+	check := checkDigit(normalized.integer + normalized.decimal)
+	return renderAmount(normalized, opts) + " " + lex.CheckCode() + " " + lex.Digit(check), nil
+}
@@ -0,0 +1,75 @@
+package thbtextizer
+
+import (
+	"testing"
+)
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected int
+	}{
+		{"123.45", 6},   // 1+2+3+4+5 = 15
+		{"100", 1},      // 1+0+0+0+0 = 1
+		{"0", 0},        // 0
+		{"9", 0},        // 9 mod 9
+		{"1,234.56", 3}, // 21 mod 9
+		{"0.456", 1},    // rounds to 0.46 = 10 mod 9
+	}
+
+	for _, test := range tests {
+		result, err := CheckDigit(test.input)
+		if err != nil {
+			t.Errorf("CheckDigit(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("CheckDigit(%v) = %d, expected %d", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := CheckDigit("abc"); err == nil {
+		t.Errorf("CheckDigit(abc) expected error, got nil")
+	}
+}
+
+func TestConvertWithCheck(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"123.45", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์ รหัสตรวจสอบ หก"},
+		{100, "หนึ่งร้อยบาทถ้วน รหัสตรวจสอบ หนึ่ง"},
+		{"0", "ศูนย์บาทถ้วน รหัสตรวจสอบ ศูนย์"},
+		{"4,500", "สี่พันห้าร้อยบาทถ้วน รหัสตรวจสอบ ศูนย์"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertWithCheck(test.input)
+		if err != nil {
+			t.Errorf("ConvertWithCheck(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertWithCheck(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestConverterConvertWithCheck(t *testing.T) {
+	digits := [10]string{"ศูน", "นึ่ง", "สอง", "สาม", "สี่", "ห้า", "หก", "เจ็ด", "แปด", "เก้า"}
+	converter := NewConverter(&Config{DigitWords: digits})
+
+	result, err := converter.ConvertWithCheck("100")
+	if err != nil {
+		t.Fatalf("ConvertWithCheck(100) returned error: %v", err)
+	}
+	if expected := "นึ่งร้อยบาทถ้วน รหัสตรวจสอบ นึ่ง"; result != expected {
+		t.Errorf("ConvertWithCheck(100) with DigitWords = %s, expected %s", result, expected)
+	}
+
+	result, _ = converter.ConvertWithCheck("4,500")
+	if expected := "สี่พันห้าร้อยบาทถ้วน รหัสตรวจสอบ ศูน"; result != expected {
+		t.Errorf("ConvertWithCheck(4,500) with DigitWords = %s, expected %s", result, expected)
+	}
+}
@@ -0,0 +1,47 @@
+package thbtextizer
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConvertContext converts amount like Convert but stops between six-digit
+// groups once ctx is done. A canceled conversion returns an
+// ErrorCodeCanceled error that wraps ctx.Err(), so errors.Is(err,
+// context.Canceled) works.
+func ConvertContext(ctx context.Context, amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertContext(ctx, amount, mode, globalOptions())
+}
+
+// ConvertContext converts amount with cancellation using instance configuration
+func (c *Converter) ConvertContext(ctx context.Context, amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertContext(ctx, amount, mode, c.config.options())
+}
+
+func convertContext(ctx context.Context, amount any, mode DecimalRoundingMode, opts convertOptions) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", localizeError(newCanceledError(fmt.Sprintf("%v", amount), err), opts.errorLanguage)
+	}
+
+	opts.ctx = ctx
+	text, err := convertWithMode(amount, mode, opts)
+	if err != nil {
+		return "", err
+	}
+
+	// The reading may have been cut short between groups
+	if err := ctx.Err(); err != nil {
+		return "", localizeError(newCanceledError(fmt.Sprintf("%v", amount), err), opts.errorLanguage)
+	}
+	return text, nil
+}
@@ -0,0 +1,56 @@
+package thbtextizer
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestConvertContext(t *testing.T) {
+	ctx := context.Background()
+	for _, input := range []any{"123.45", 9223372036854775807, "-1000000000000"} {
+		result, err := ConvertContext(ctx, input)
+		if err != nil {
+			t.Errorf("ConvertContext(%v) returned error: %v", input, err)
+			continue
+		}
+		expected, _ := Convert(input)
+		if result != expected {
+			t.Errorf("ConvertContext(%v) = %s, expected %s", input, result, expected)
+		}
+	}
+
+	converter := NewConverter(&Config{DefaultRounding: RoundDown})
+	result, err := converter.ConvertContext(ctx, "1.999")
+	if err != nil {
+		t.Fatalf("ConvertContext(1.999) returned error: %v", err)
+	}
+	if expected := "หนึ่งบาทเก้าสิบเก้าสตางค์"; result != expected {
+		t.Errorf("ConvertContext(1.999) = %s, expected %s", result, expected)
+	}
+}
+
+func TestConvertContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ConvertContext(ctx, "9223372036854775807")
+	if err == nil {
+		t.Fatal("ConvertContext with canceled context expected error, got nil")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ConvertContext error %v does not wrap context.Canceled", err)
+	}
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Code != ErrorCodeCanceled {
+		t.Errorf("ConvertContext returned %v, expected ErrorCodeCanceled", err)
+	}
+
+	// Cancellation between groups cuts the reading short and is reported
+	ctx, cancel = context.WithCancel(context.Background())
+	opts := convertOptions{ctx: ctx}
+	cancel()
+	if text := buildThaiText(parseDigits("9223372036854775807"), opts); text != "" {
+		t.Errorf("buildThaiText after cancel = %s, expected empty", text)
+	}
+}
@@ -0,0 +1,125 @@
+package thbtextizer
+
+import (
+	"strings"
+)
+
+// Currency holds the unit words attached to a reading. Amounts are read to
+// Config.MinorUnitDigits subunit digits, two by default, so with the default
+// Currency suits any currency with 100 subunits per unit.
+type Currency struct {
+	Code    string // key in ConvertMulti results, e.g. "THB"
+	Unit    string // word after the integer reading, e.g. "บาท"
+	SubUnit string // word after the subunit reading, e.g. "สตางค์"
+	Even    string // word after whole amounts, e.g. "ถ้วน"; may be empty
+}
+
+// THB is the baht currency used by Convert
+var THB = Currency{Code: "THB", Unit: "บาท", SubUnit: "สตางค์", Even: "ถ้วน"}
+
+// ConvertMulti reads amount once per currency and returns the readings keyed
+// by currency code. The amount is normalized and its digits are read only
+// once; each currency only changes the unit words:
+//
+//	ConvertMulti(123.45, []Currency{THB, {Code: "USD", Unit: "ดอลลาร์", SubUnit: "เซนต์", Even: "ถ้วน"}})
+//	// map[THB:หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์ USD:หนึ่งร้อยยี่สิบสามดอลลาร์สี่สิบห้าเซนต์]
+func ConvertMulti(amount any, currencies []Currency, roundingMode ...DecimalRoundingMode) (map[string]string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertMulti(amount, currencies, mode, globalOptions())
+}
+
+// ConvertMulti reads amount once per currency using instance configuration
+func (c *Converter) ConvertMulti(amount any, currencies []Currency, roundingMode ...DecimalRoundingMode) (map[string]string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertMulti(amount, currencies, mode, c.config.options())
+}
+
+func convertMulti(amount any, currencies []Currency, mode DecimalRoundingMode, opts convertOptions) (map[string]string, error) {
+	if err := validateCurrencies(currencies); err != nil {
+		return nil, localizeError(err, opts.errorLanguage)
+	}
+
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	reading := readAmount(normalized, opts)
+	results := make(map[string]string, len(currencies))
+	for _, currency := range currencies {
+		results[currency.Code] = renderReading(reading, currency, opts)
+	}
+
+	return results, nil
+}
+
+// validateCurrencies rejects currencies that cannot be told apart in the
+// result map or that have no unit words
+func validateCurrencies(currencies []Currency) error {
+	seen := make(map[string]bool, len(currencies))
+	for _, currency := range currencies {
+		if currency.Code == "" {
+			return newInvalidInputError(currency.Unit, reasonEmptyCurrencyCode)
+		}
+		if currency.Unit == "" || currency.SubUnit == "" {
+			return newInvalidInputError(currency.Code, reasonEmptyCurrencyWords)
+		}
+		if seen[currency.Code] {
+			return newInvalidInputError(currency.Code, reasonDuplicateCurrency, currency.Code)
+		}
+		seen[currency.Code] = true
+	}
+	return nil
+}
+
+// currencyMarkers are the baht markers Config.StripCurrencyMarkers removes
+// from string input; THB matches in any case
+var currencyMarkers = []string{"฿", "THB", "บาท"}
+
+// stripCurrencyMarkers removes one currency marker from each end of input,
+// so "฿1,234.50", "1234.50 THB" and "-฿5" read as plain numbers. A sign
+// may come before or after a leading marker. Input without a marker is
+// returned unchanged.
+func stripCurrencyMarkers(input string) string {
+	sign, text := cutSign(strings.TrimSpace(input))
+	stripped := false
+
+	for _, marker := range currencyMarkers {
+		if len(text) >= len(marker) && strings.EqualFold(text[:len(marker)], marker) {
+			text = strings.TrimSpace(text[len(marker):])
+			stripped = true
+			break
+		}
+	}
+	if sign == "" {
+		sign, text = cutSign(text)
+	}
+	for _, marker := range currencyMarkers {
+		if len(text) >= len(marker) && strings.EqualFold(text[len(text)-len(marker):], marker) {
+			text = strings.TrimSpace(text[:len(text)-len(marker)])
+			stripped = true
+			break
+		}
+	}
+
+	if !stripped {
+		return input
+	}
+	return sign + text
+}
+
+// cutSign splits a leading minus or plus sign from text
+func cutSign(text string) (string, string) {
+	if strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") {
+		return text[:1], strings.TrimSpace(text[1:])
+	}
+	return "", text
+}
@@ -0,0 +1,134 @@
+package thbtextizer
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConvertMulti(t *testing.T) {
+	usd := Currency{Code: "USD", Unit: "ดอลลาร์", SubUnit: "เซนต์", Even: "ถ้วน"}
+	currencies := []Currency{THB, usd}
+
+	tests := []struct {
+		input    any
+		expected map[string]string
+	}{
+		{123.45, map[string]string{
+			"THB": "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์",
+			"USD": "หนึ่งร้อยยี่สิบสามดอลลาร์สี่สิบห้าเซนต์",
+		}},
+		{"100", map[string]string{
+			"THB": "หนึ่งร้อยบาทถ้วน",
+			"USD": "หนึ่งร้อยดอลลาร์ถ้วน",
+		}},
+		{"-0.5", map[string]string{
+			"THB": "ลบศูนย์บาทห้าสิบสตางค์",
+			"USD": "ลบศูนย์ดอลลาร์ห้าสิบเซนต์",
+		}},
+	}
+
+	for _, test := range tests {
+		results, err := ConvertMulti(test.input, currencies)
+		if err != nil {
+			t.Errorf("ConvertMulti(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if len(results) != len(test.expected) {
+			t.Errorf("ConvertMulti(%v) returned %d readings, expected %d", test.input, len(results), len(test.expected))
+		}
+		for code, expected := range test.expected {
+			if results[code] != expected {
+				t.Errorf("ConvertMulti(%v)[%s] = %s, expected %s", test.input, code, results[code], expected)
+			}
+		}
+
+		// The baht reading must match Convert
+		single, _ := Convert(test.input)
+		if results["THB"] != single {
+			t.Errorf("ConvertMulti(%v)[THB] = %s, Convert = %s", test.input, results["THB"], single)
+		}
+	}
+}
+
+func TestConvertMultiConverter(t *testing.T) {
+	converter := NewConverter(&Config{DefaultRounding: RoundDown, Suffix: " (รวมภาษี)"})
+	results, err := converter.ConvertMulti("1.999", []Currency{THB, {Code: "EUR", Unit: "ยูโร", SubUnit: "เซนต์"}})
+	if err != nil {
+		t.Fatalf("ConvertMulti(1.999) returned error: %v", err)
+	}
+
+	expected := map[string]string{
+		"THB": "หนึ่งบาทเก้าสิบเก้าสตางค์ (รวมภาษี)",
+		"EUR": "หนึ่งยูโรเก้าสิบเก้าเซนต์ (รวมภาษี)",
+	}
+	for code, want := range expected {
+		if results[code] != want {
+			t.Errorf("ConvertMulti(1.999)[%s] = %s, expected %s", code, results[code], want)
+		}
+	}
+}
+
+func TestConvertMultiInvalidCurrencies(t *testing.T) {
+	tests := []struct {
+		name       string
+		currencies []Currency
+	}{
+		{"empty code", []Currency{{Unit: "บาท", SubUnit: "สตางค์"}}},
+		{"empty unit", []Currency{{Code: "XXX", SubUnit: "สตางค์"}}},
+		{"duplicate code", []Currency{THB, THB}},
+	}
+
+	for _, test := range tests {
+		_, err := ConvertMulti("1", test.currencies)
+		if err == nil {
+			t.Errorf("ConvertMulti with %s expected error, got nil", test.name)
+			continue
+		}
+		if convErr, ok := err.(*ConversionError); !ok || convErr.Code != ErrorCodeInvalidInput {
+			t.Errorf("ConvertMulti with %s returned %v, expected ErrorCodeInvalidInput", test.name, err)
+		}
+	}
+}
+
+func TestStripCurrencyMarkers(t *testing.T) {
+	converter := NewConverter(&Config{StripCurrencyMarkers: true})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"฿1,234.50", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์"},
+		{"1234.50 THB", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์"},
+		{"THB 1234.50", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์"},
+		{"1234.5thb", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์"},
+		{"100บาท", "หนึ่งร้อยบาทถ้วน"},
+		{" ฿ 100 บาท ", "หนึ่งร้อยบาทถ้วน"},
+		{"-฿5", "ลบห้าบาทถ้วน"},
+		{"฿-5", "ลบห้าบาทถ้วน"},
+		{"-5 THB", "ลบห้าบาทถ้วน"},
+		{"1,000", "หนึ่งพันบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%q) with StripCurrencyMarkers returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%q) with StripCurrencyMarkers = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// Only one marker per side is removed, and never from the middle
+	for _, input := range []string{"1฿2", "฿฿1", "฿", "THB"} {
+		if _, err := converter.Convert(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%q) with StripCurrencyMarkers error = %v, expected invalid input", input, err)
+		}
+	}
+
+	// Markers are rejected without the option
+	if _, err := Convert("฿1,234.50"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Convert(฿1,234.50) error = %v, expected invalid input", err)
+	}
+}
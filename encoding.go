@@ -0,0 +1,29 @@
+package thbtextizer
+
+// ConvertTIS620 converts amount and encodes the Thai text as TIS-620 bytes for
+// legacy printers and systems that do not accept UTF-8. An ErrorCodeInvalidInput
+// error is returned if the text contains a rune TIS-620 cannot represent.
+func ConvertTIS620(amount any, roundingMode ...DecimalRoundingMode) ([]byte, error) {
+	text, err := Convert(amount, roundingMode...)
+	if err != nil {
+		return nil, err
+	}
+	return encodeTIS620(text)
+}
+
+// encodeTIS620 maps ASCII unchanged and the Thai block U+0E01–U+0E5B onto
+// 0xA1–0xFB, the layout shared by TIS-620 and ISO-8859-11
+func encodeTIS620(text string) ([]byte, error) {
+	encoded := make([]byte, 0, len(text)/3+1)
+	for i, r := range []rune(text) {
+		switch {
+		case r < 0x80:
+			encoded = append(encoded, byte(r))
+		case (r >= 0x0E01 && r <= 0x0E3A) || (r >= 0x0E3F && r <= 0x0E5B):
+			encoded = append(encoded, byte(r-0x0E01+0xA1))
+		default:
+			return nil, newInvalidInputError(text, reasonNotTIS620, r, i)
+		}
+	}
+	return encoded, nil
+}
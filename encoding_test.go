@@ -0,0 +1,51 @@
+package thbtextizer
+
+import (
+	"testing"
+)
+
+// decodeTIS620 is the inverse of encodeTIS620, used to verify round-trips
+func decodeTIS620(data []byte) string {
+	runes := make([]rune, 0, len(data))
+	for _, b := range data {
+		if b < 0x80 {
+			runes = append(runes, rune(b))
+		} else {
+			runes = append(runes, rune(b)-0xA1+0x0E01)
+		}
+	}
+	return string(runes)
+}
+
+func TestConvertTIS620(t *testing.T) {
+	inputs := []any{"123.45", "1,000,000", 21, "0.01"}
+
+	for _, input := range inputs {
+		expected, err := Convert(input)
+		if err != nil {
+			t.Fatalf("Convert(%v) returned error: %v", input, err)
+		}
+
+		encoded, err := ConvertTIS620(input)
+		if err != nil {
+			t.Errorf("ConvertTIS620(%v) returned error: %v", input, err)
+			continue
+		}
+		if len(encoded) != len([]rune(expected)) {
+			t.Errorf("ConvertTIS620(%v) produced %d bytes, expected one per rune (%d)", input, len(encoded), len([]rune(expected)))
+		}
+		if decoded := decodeTIS620(encoded); decoded != expected {
+			t.Errorf("ConvertTIS620(%v) decoded to %s, expected %s", input, decoded, expected)
+		}
+	}
+
+	// "ก" is the first Thai letter and maps to 0xA1
+	encoded, err := encodeTIS620("ก1")
+	if err != nil || len(encoded) != 2 || encoded[0] != 0xA1 || encoded[1] != '1' {
+		t.Errorf("encodeTIS620(ก1) = %v, %v, expected [0xA1 0x31]", encoded, err)
+	}
+
+	if _, err := encodeTIS620("฿€"); err == nil {
+		t.Errorf("encodeTIS620 with unrepresentable rune expected error, got nil")
+	}
+}
@@ -0,0 +1,150 @@
+package thbtextizer
+
+import (
+	"strconv"
+	"strings"
+)
+
+// EnglishUnits holds the singular and plural currency words used by the
+// English reading. The plural forms are used for every value except one.
+type EnglishUnits struct {
+	Unit          string // e.g. "dollar"
+	UnitPlural    string // e.g. "dollars"
+	SubUnit       string // e.g. "cent"
+	SubUnitPlural string // e.g. "cents"
+}
+
+// EnglishBaht is the default English unit set. Baht and satang are invariant
+// in English, so the singular and plural forms are the same.
+var EnglishBaht = EnglishUnits{Unit: "baht", UnitPlural: "baht", SubUnit: "satang", SubUnitPlural: "satang"}
+
+var (
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	englishTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// ConvertEnglish converts amount to an English reading in baht, such as
+// "one hundred twenty-three baht and forty-five satang". Only the English
+// reading picks singular or plural unit words; Thai output has no plural and
+// is unaffected.
+func ConvertEnglish(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertEnglish(amount, mode, globalOptions(), EnglishBaht)
+}
+
+// ConvertEnglish converts amount to an English reading using instance
+// configuration, including Config.EnglishUnits
+func (c *Converter) ConvertEnglish(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	units := EnglishBaht
+	if c.config.EnglishUnits != nil {
+		units = *c.config.EnglishUnits
+	}
+
+	return convertEnglish(amount, mode, c.config.options(), units)
+}
+
+func convertEnglish(amount any, mode DecimalRoundingMode, opts convertOptions, units EnglishUnits) (string, error) {
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return renderEnglish(normalized, units), nil
+}
+
+// renderEnglish writes the English reading of a normalized amount
+func renderEnglish(n normalizedAmount, units EnglishUnits) string {
+	var builder strings.Builder
+	builder.Grow(128)
+
+	if n.negative {
+		builder.WriteString("minus ")
+	}
+
+	integer := strings.TrimLeft(n.integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+	builder.WriteString(readEnglishInteger(integer))
+	builder.WriteByte(' ')
+	builder.WriteString(pluralize(integer == "1", units.Unit, units.UnitPlural))
+
+	if !n.isEven() {
+		// MinorUnitDigits can make the subunit value exceed 999
+		satang := strings.TrimLeft(n.decimal, "0")
+		builder.WriteString(" and ")
+		builder.WriteString(readEnglishInteger(satang))
+		builder.WriteByte(' ')
+		builder.WriteString(pluralize(satang == "1", units.SubUnit, units.SubUnitPlural))
+	}
+
+	return builder.String()
+}
+
+// pluralize returns singular for a count of one and plural otherwise
+func pluralize(one bool, singular, plural string) string {
+	if one {
+		return singular
+	}
+	return plural
+}
+
+// readEnglishInteger reads a string of digits without leading zeros in
+// English short-scale words
+func readEnglishInteger(digits string) string {
+	if digits == "0" {
+		return englishOnes[0]
+	}
+
+	// Split into groups of three from the right
+	var groups []int
+	for end := len(digits); end > 0; end -= 3 {
+		start := max(end-3, 0)
+		group, _ := strconv.Atoi(digits[start:end])
+		groups = append(groups, group)
+	}
+
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+		words = append(words, readEnglishBelowThousand(groups[i]))
+		if englishScales[i] != "" {
+			words = append(words, englishScales[i])
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// readEnglishBelowThousand reads 1-999 in English words
+func readEnglishBelowThousand(n int) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, englishOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20 && n%10 != 0:
+		words = append(words, englishTens[n/10]+"-"+englishOnes[n%10])
+	case n >= 20:
+		words = append(words, englishTens[n/10])
+	case n > 0:
+		words = append(words, englishOnes[n])
+	}
+	return strings.Join(words, " ")
+}
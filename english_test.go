@@ -0,0 +1,96 @@
+package thbtextizer
+
+import (
+	"testing"
+)
+
+func TestConvertEnglish(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"0", "zero baht"},
+		{"1", "one baht"},
+		{"2", "two baht"},
+		{"0.01", "zero baht and one satang"},
+		{"0.02", "zero baht and two satang"},
+		{"123.45", "one hundred twenty-three baht and forty-five satang"},
+		{"1000000", "one million baht"},
+		{"1001.10", "one thousand one baht and ten satang"},
+		{"-21", "minus twenty-one baht"},
+		{"9223372036854775807", "nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven baht"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertEnglish(test.input)
+		if err != nil {
+			t.Errorf("ConvertEnglish(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertEnglish(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestConvertEnglishPluralization(t *testing.T) {
+	dollars := &EnglishUnits{Unit: "dollar", UnitPlural: "dollars", SubUnit: "cent", SubUnitPlural: "cents"}
+	converter := NewConverter(&Config{DefaultRounding: RoundHalf, EnglishUnits: dollars})
+
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"0.01", "zero dollars and one cent"},
+		{"0.02", "zero dollars and two cents"},
+		{"1", "one dollar"},
+		{"2", "two dollars"},
+		{"1.01", "one dollar and one cent"},
+		{"-1.5", "minus one dollar and fifty cents"},
+		{"11", "eleven dollars"},
+		{"21.21", "twenty-one dollars and twenty-one cents"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.ConvertEnglish(test.input)
+		if err != nil {
+			t.Errorf("ConvertEnglish(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertEnglish(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// Thai output has no plural and is unaffected by EnglishUnits
+	result, _ := converter.Convert("0.02")
+	if expected := "ศูนย์บาทสองสตางค์"; result != expected {
+		t.Errorf("Convert(0.02) = %s, expected %s", result, expected)
+	}
+}
+
+func TestConvertEnglishMinorUnitDigits(t *testing.T) {
+	tests := []struct {
+		digits   int
+		input    string
+		expected string
+	}{
+		{3, "1.234", "one baht and two hundred thirty-four satang"},
+		{3, "0.001", "zero baht and one satang"},
+		{4, "1.2345", "one baht and two thousand three hundred forty-five satang"},
+		{5, "1.00012", "one baht and twelve satang"},
+		{6, "2.999999", "two baht and nine hundred ninety-nine thousand nine hundred ninety-nine satang"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{MinorUnitDigits: test.digits})
+		result, err := converter.ConvertEnglish(test.input)
+		if err != nil {
+			t.Errorf("ConvertEnglish(%s) with MinorUnitDigits %d returned error: %v", test.input, test.digits, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertEnglish(%s) with MinorUnitDigits %d = %s, expected %s", test.input, test.digits, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package thbtextizer
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// referenceReading spells n with the textbook recursion, independently of
+// buildThaiText: a number of a million or more reads as its millions, then
+// "ล้าน", then the remainder below a million
+func referenceReading(n uint64) string {
+	if n == 0 {
+		return "ศูนย์"
+	}
+	return referenceMillions(n)
+}
+
+func referenceMillions(n uint64) string {
+	if n < 1_000_000 {
+		return referenceBelowMillion(n, false)
+	}
+	return referenceMillions(n/1_000_000) + "ล้าน" + referenceBelowMillion(n%1_000_000, true)
+}
+
+// referenceBelowMillion spells n below a million; afterMillions tells a
+// trailing 1 that digits come before it, so it reads "เอ็ด"
+func referenceBelowMillion(n uint64, afterMillions bool) string {
+	digits := []string{"", "หนึ่ง", "สอง", "สาม", "สี่", "ห้า", "หก", "เจ็ด", "แปด", "เก้า"}
+	places := []string{"แสน", "หมื่น", "พัน", "ร้อย"}
+
+	text := ""
+	for i, divisor := range []uint64{100_000, 10_000, 1_000, 100} {
+		if digit := n / divisor % 10; digit > 0 {
+			text += digits[digit] + places[i]
+		}
+	}
+
+	switch tens := n / 10 % 10; tens {
+	case 0:
+	case 1:
+		text += "สิบ"
+	case 2:
+		text += "ยี่สิบ"
+	default:
+		text += digits[tens] + "สิบ"
+	}
+
+	switch ones := n % 10; {
+	case ones == 1 && (n >= 10 || afterMillions):
+		text += "เอ็ด"
+	default:
+		text += digits[ones]
+	}
+	return text
+}
+
+// checkReference converts n and compares the baht reading with the reference
+func checkReference(t *testing.T, n uint64) {
+	t.Helper()
+
+	input := strconv.FormatUint(n, 10)
+	result, err := Convert(input)
+	if err != nil {
+		t.Fatalf("Convert(%s) returned error: %v", input, err)
+	}
+	if expected := referenceReading(n) + "บาทถ้วน"; result != expected {
+		t.Fatalf("Convert(%s) = %s, expected %s", input, result, expected)
+	}
+}
+
+func FuzzConvert(f *testing.F) {
+	for _, seed := range []uint64{
+		0, 1, 11, 21, 101, 1_000_000, 1_000_001, 10_000_001,
+		1_000_000_000_000, 1_000_000_000_001, 1_000_001_000_000,
+		1_000_000_000_000_000_001, 1_000_000_000_001_000_000, 9_223_372_036_854_775_807,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, n uint64) {
+		// Keep n within MaxSupportedValue, the int64 maximum
+		checkReference(t, n&(1<<63-1))
+	})
+}
+
+func TestConvertMatchesReference(t *testing.T) {
+	// Mostly-zero digits hit the all-zero groups that grouping gets wrong
+	random := rand.New(rand.NewPCG(1, 2))
+	for range 20000 {
+		length := 1 + random.IntN(19)
+		var n uint64
+		for position := range length {
+			digit := uint64(0)
+			if position == 0 || random.IntN(3) == 0 {
+				digit = 1 + random.Uint64N(9)
+			}
+			n = n*10 + digit
+		}
+		checkReference(t, n&(1<<63-1))
+	}
+}
+
+func TestMillionCountInvariant(t *testing.T) {
+	// buildThaiText has no digit limit of its own, so groups beyond
+	// MaxSupportedValue are checked through convertIntegerNumber
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1000000000000000000", "หนึ่งล้านล้านล้าน"},
+		{"1234567000000000000", "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดล้านล้าน"},
+		{"12345678000000000000", "สิบสองล้านสามแสนสี่หมื่นห้าพันหกร้อยเจ็ดสิบแปดล้านล้าน"},
+		{"12345678000000000001", "สิบสองล้านสามแสนสี่หมื่นห้าพันหกร้อยเจ็ดสิบแปดล้านล้านเอ็ด"},
+		{"1000000000000000000000000", "หนึ่งล้านล้านล้านล้าน"},
+		{"1000000000001000000000000", "หนึ่งล้านล้านเอ็ดล้านล้าน"},
+	}
+
+	for _, test := range tests {
+		if result := convertIntegerNumber(test.input, convertOptions{}); result != test.expected {
+			t.Errorf("convertIntegerNumber(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// A number whose leading non-zero group is k groups from the right
+	// reads exactly k ล้าน
+	random := rand.New(rand.NewPCG(3, 4))
+	for range 5000 {
+		length := 1 + random.IntN(36)
+		digits := make([]byte, length)
+		for i := range digits {
+			digits[i] = '0'
+			if i == 0 || random.IntN(4) == 0 {
+				digits[i] = byte('1' + random.IntN(9))
+			}
+		}
+
+		input := string(digits)
+		expected := (length - 1) / 6
+		if count := strings.Count(convertIntegerNumber(input, convertOptions{}), "ล้าน"); count != expected {
+			t.Fatalf("convertIntegerNumber(%s) reads ล้าน %d times, expected %d", input, count, expected)
+		}
+		if length <= 19 {
+			if n, err := strconv.ParseUint(input, 10, 64); err == nil {
+				if result := convertIntegerNumber(input, convertOptions{}); result != referenceReading(n) {
+					t.Fatalf("convertIntegerNumber(%s) = %s, expected %s", input, result, referenceReading(n))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package thbtextizer
+
+import (
+	"strings"
+)
+
+// ConvertHybrid writes the rounded amount as grouped Western Arabic digits
+// while keeping the currency words spelled, for tables where the figure is
+// scanned but the currency is read:
+//
+//	123456    -> "123,456 บาทถ้วน"
+//	1234.567  -> "1,234 บาท 57 สตางค์"
+//
+// Negative amounts start with "-", or are wrapped in parentheses under
+// Config.NegativeStyle NegativeParentheses.
+func ConvertHybrid(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertHybrid(amount, mode, globalOptions())
+}
+
+// ConvertHybrid writes the amount as grouped digits with spelled currency
+// words using instance configuration
+func (c *Converter) ConvertHybrid(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertHybrid(amount, mode, c.config.options())
+}
+
+func convertHybrid(amount any, mode DecimalRoundingMode, opts convertOptions) (string, error) {
+	n, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	builder.Grow(64)
+
+	parenthesized := n.negative && opts.negativeStyle == NegativeParentheses
+	switch {
+	case parenthesized:
+		builder.WriteByte('(')
+	case n.negative:
+		builder.WriteByte('-')
+	}
+
+	integer := strings.TrimLeft(n.integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+	units := opts.units()
+	builder.WriteString(groupThousands(integer))
+	builder.WriteByte(' ')
+	builder.WriteString(units.Unit)
+
+	if n.isEven() {
+		if !opts.omitEvenSuffix {
+			builder.WriteString(units.Even)
+		}
+	} else {
+		builder.WriteByte(' ')
+		builder.WriteString(n.decimal)
+		builder.WriteByte(' ')
+		builder.WriteString(units.SubUnit)
+	}
+
+	builder.WriteString(opts.suffix)
+	if parenthesized {
+		builder.WriteByte(')')
+	}
+
+	return builder.String(), nil
+}
+
+// groupThousands inserts a comma between every three digits from the right
+func groupThousands(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(digits) + len(digits)/3)
+
+	lead := len(digits) % 3
+	if lead == 0 {
+		lead = 3
+	}
+	builder.WriteString(digits[:lead])
+	for i := lead; i < len(digits); i += 3 {
+		builder.WriteByte(',')
+		builder.WriteString(digits[i : i+3])
+	}
+
+	return builder.String()
+}
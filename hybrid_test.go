@@ -0,0 +1,72 @@
+package thbtextizer
+
+import (
+	"testing"
+)
+
+func TestConvertHybrid(t *testing.T) {
+	tests := []struct {
+		input    any
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{"123456", RoundHalf, "123,456 บาทถ้วน"},
+		{0, RoundHalf, "0 บาทถ้วน"},
+		{"100", RoundHalf, "100 บาทถ้วน"},
+		{"1000", RoundHalf, "1,000 บาทถ้วน"},
+		{"1,234,567.5", RoundHalf, "1,234,567 บาท 50 สตางค์"},
+		{"1234.567", RoundHalf, "1,234 บาท 57 สตางค์"},
+		{"1234.567", RoundDown, "1,234 บาท 56 สตางค์"},
+		{"0.05", RoundHalf, "0 บาท 05 สตางค์"},
+		{"-9876543.21", RoundHalf, "-9,876,543 บาท 21 สตางค์"},
+		{"9223372036854775807", RoundHalf, "9,223,372,036,854,775,807 บาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertHybrid(test.input, test.mode)
+		if err != nil {
+			t.Errorf("ConvertHybrid(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertHybrid(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := ConvertHybrid("abc"); err == nil {
+		t.Errorf("ConvertHybrid(abc) expected error, got nil")
+	}
+
+	converter := NewConverter(&Config{DefaultRounding: RoundUp, AllowOverflow: true})
+	result, err := converter.ConvertHybrid("999.995")
+	if err != nil {
+		t.Fatalf("ConvertHybrid(999.995) returned error: %v", err)
+	}
+	if expected := "1,000 บาทถ้วน"; result != expected {
+		t.Errorf("ConvertHybrid(999.995) with overflow = %s, expected %s", result, expected)
+	}
+}
+
+func TestConvertHybridNegativeStyle(t *testing.T) {
+	tests := []struct {
+		config   *Config
+		input    string
+		expected string
+	}{
+		{&Config{NegativeStyle: NegativeParentheses}, "-1234.05", "(1,234 บาท 05 สตางค์)"},
+		{&Config{NegativeStyle: NegativeParentheses, Suffix: " (ค้างชำระ)"}, "-100", "(100 บาทถ้วน (ค้างชำระ))"},
+		{&Config{NegativeStyle: NegativeParentheses}, "1234.05", "1,234 บาท 05 สตางค์"},
+		{&Config{}, "-1234.05", "-1,234 บาท 05 สตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := NewConverter(test.config).ConvertHybrid(test.input)
+		if err != nil {
+			t.Errorf("ConvertHybrid(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertHybrid(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package thbtextizer
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ConvertScaled converts a decimal stored as an unscaled integer and a scale,
+// such as a database NUMERIC column, where the value is unscaled × 10^-scale.
+// The digits are read exactly without going through float, and the rounding
+// mode applies when scale is greater than 2.
+func ConvertScaled(unscaled *big.Int, scale int, roundingMode ...DecimalRoundingMode) (string, error) {
+	amountStr, err := scaledToString(unscaled, scale)
+	if err != nil {
+		return "", err
+	}
+	return Convert(amountStr, roundingMode...)
+}
+
+// ConvertScaled converts an unscaled integer and scale using instance configuration
+func (c *Converter) ConvertScaled(unscaled *big.Int, scale int, roundingMode ...DecimalRoundingMode) (string, error) {
+	amountStr, err := scaledToString(unscaled, scale)
+	if err != nil {
+		return "", err
+	}
+	return c.convertPlain(amountStr, roundingMode)
+}
+
+// convertPlain converts a decimal string built by this package, which always
+// uses the standard separators whatever the instance configuration says
+func (c *Converter) convertPlain(amountStr string, roundingMode []DecimalRoundingMode) (string, error) {
+	opts := c.config.options()
+	opts.decimalSeparator, opts.thousandSeparator = 0, 0
+	return c.convertWithOptions(amountStr, roundingMode, opts)
+}
+
+// scaledToString renders unscaled × 10^-scale as a plain decimal string
+func scaledToString(unscaled *big.Int, scale int) (string, error) {
+	if unscaled == nil {
+		return "", newInvalidInputError("<nil>", reasonNilUnscaled)
+	}
+
+	digits := unscaled.String()
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign = "-"
+		digits = digits[1:]
+	}
+
+	if scale <= 0 {
+		return sign + digits + strings.Repeat("0", -scale), nil
+	}
+
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	point := len(digits) - scale
+
+	return sign + digits[:point] + "." + digits[point:], nil
+}
+
+// ConvertMoney converts a protobuf-style money value, such as google.type.Money,
+// given as whole units and nanos (billionths of a unit). Nanos must be within
+// ±999,999,999 and carry the same sign as units; they are rounded to satang
+// with the rounding mode.
+func ConvertMoney(units int64, nanos int32, roundingMode ...DecimalRoundingMode) (string, error) {
+	amountStr, err := moneyToString(units, nanos)
+	if err != nil {
+		return "", err
+	}
+	return Convert(amountStr, roundingMode...)
+}
+
+// ConvertMoney converts a units/nanos money value using instance configuration
+func (c *Converter) ConvertMoney(units int64, nanos int32, roundingMode ...DecimalRoundingMode) (string, error) {
+	amountStr, err := moneyToString(units, nanos)
+	if err != nil {
+		return "", err
+	}
+	return c.convertPlain(amountStr, roundingMode)
+}
+
+// moneyToString renders units and nanos as a plain decimal string
+func moneyToString(units int64, nanos int32) (string, error) {
+	input := fmt.Sprintf("{units:%d nanos:%d}", units, nanos)
+
+	if nanos <= -1_000_000_000 || nanos >= 1_000_000_000 {
+		return "", newInvalidInputError(input, reasonNanosRange)
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return "", newInvalidInputError(input, reasonNanosSign)
+	}
+
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+	}
+	unitsStr := strings.TrimPrefix(strconv.FormatInt(units, 10), "-")
+	nanosStr := strings.TrimPrefix(strconv.FormatInt(int64(nanos), 10), "-")
+
+	return sign + unitsStr + "." + strings.Repeat("0", 9-len(nanosStr)) + nanosStr, nil
+}
+
+// ConvertFraction converts the exact value of num/den, rounding to satang
+// with the rounding mode without any float division. A zero denominator is
+// an ErrorCodeInvalidInput error.
+func ConvertFraction(num, den int64, roundingMode ...DecimalRoundingMode) (string, error) {
+	amountStr, err := fractionToString(num, den, globalOptions().minorDigits())
+	if err != nil {
+		return "", err
+	}
+	return Convert(amountStr, roundingMode...)
+}
+
+// ConvertFraction converts num/den exactly using instance configuration
+func (c *Converter) ConvertFraction(num, den int64, roundingMode ...DecimalRoundingMode) (string, error) {
+	amountStr, err := fractionToString(num, den, c.config.options().minorDigits())
+	if err != nil {
+		return "", err
+	}
+	return c.convertPlain(amountStr, roundingMode)
+}
+
+// fractionToString renders num/den with one exact decimal beyond the places
+// minor unit digits followed by a sticky "1" when the division does not
+// terminate there, which is all the rounding modes need to round it
+// correctly to the minor unit
+func fractionToString(num, den int64, places int) (string, error) {
+	if den == 0 {
+		return "", newInvalidInputError(fmt.Sprintf("%d/%d", num, den), reasonZeroDenominator)
+	}
+
+	numerator := new(big.Int).Abs(big.NewInt(num))
+	denominator := new(big.Int).Abs(big.NewInt(den))
+
+	scaled := new(big.Int).Mul(numerator, big.NewInt(pow10(places+1)))
+	quotient, remainder := new(big.Int).QuoRem(scaled, denominator, new(big.Int))
+
+	amountStr, err := scaledToString(quotient, places+1)
+	if err != nil {
+		return "", err
+	}
+	if remainder.Sign() != 0 {
+		amountStr += "1"
+	}
+	if (num < 0) != (den < 0) && num != 0 {
+		amountStr = "-" + amountStr
+	}
+
+	return amountStr, nil
+}
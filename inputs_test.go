@@ -0,0 +1,209 @@
+package thbtextizer
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestConvertScaled(t *testing.T) {
+	tests := []struct {
+		unscaled int64
+		scale    int
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{12345, 2, RoundHalf, "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},
+		{12345, 4, RoundHalf, "หนึ่งบาทยี่สิบสามสตางค์"}, // 1.2345 -> 1.23
+		{12355, 4, RoundHalf, "หนึ่งบาทยี่สิบสี่สตางค์"}, // 1.2355 -> 1.24
+		{12355, 4, RoundDown, "หนึ่งบาทยี่สิบสามสตางค์"}, // 1.2355 -> 1.23
+		{5, 2, RoundHalf, "ศูนย์บาทห้าสตางค์"},           // 0.05
+		{5, 0, RoundHalf, "ห้าบาทถ้วน"},                  // 5
+		{5, -3, RoundHalf, "ห้าพันบาทถ้วน"},              // 5000
+		{0, 2, RoundHalf, "ศูนย์บาทถ้วน"},                // 0.00
+	}
+
+	for _, test := range tests {
+		result, err := ConvertScaled(big.NewInt(test.unscaled), test.scale, test.mode)
+		if err != nil {
+			t.Errorf("ConvertScaled(%d, %d) returned error: %v", test.unscaled, test.scale, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertScaled(%d, %d) = %s, expected %s", test.unscaled, test.scale, result, test.expected)
+		}
+	}
+
+	if _, err := ConvertScaled(nil, 2); err == nil {
+		t.Errorf("ConvertScaled(nil, 2) expected error, got nil")
+	}
+
+	converter := NewConverter(&Config{DefaultRounding: RoundDown})
+	result, err := converter.ConvertScaled(big.NewInt(12355), 4)
+	if err != nil {
+		t.Errorf("Converter.ConvertScaled returned error: %v", err)
+	}
+	if expected := "หนึ่งบาทยี่สิบสามสตางค์"; result != expected {
+		t.Errorf("Converter.ConvertScaled = %s, expected %s", result, expected)
+	}
+}
+
+func TestConvertMoney(t *testing.T) {
+	tests := []struct {
+		units    int64
+		nanos    int32
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{123, 450000000, RoundHalf, "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},
+		{123, 0, RoundHalf, "หนึ่งร้อยยี่สิบสามบาทถ้วน"},
+		{1, 125000000, RoundHalf, "หนึ่งบาทสิบสามสตางค์"}, // 1.125 -> 1.13
+		{1, 125000000, RoundDown, "หนึ่งบาทสิบสองสตางค์"}, // 1.125 -> 1.12
+		{1, 120000001, RoundUp, "หนึ่งบาทสิบสามสตางค์"},   // 1.120000001 -> 1.13
+		{0, 5000000, RoundHalf, "ศูนย์บาทหนึ่งสตางค์"},    // 0.005 -> 0.01
+		{0, 4999999, RoundHalf, "ศูนย์บาทถ้วน"},           // 0.004999999 -> 0.00
+		{0, 10000000, RoundHalf, "ศูนย์บาทหนึ่งสตางค์"},   // 0.01
+	}
+
+	for _, test := range tests {
+		result, err := ConvertMoney(test.units, test.nanos, test.mode)
+		if err != nil {
+			t.Errorf("ConvertMoney(%d, %d) returned error: %v", test.units, test.nanos, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertMoney(%d, %d, %v) = %s, expected %s", test.units, test.nanos, test.mode, result, test.expected)
+		}
+	}
+
+	invalid := []struct {
+		units int64
+		nanos int32
+	}{
+		{1, 1_000_000_000},
+		{1, -1},
+		{-1, 1},
+		{0, -1_000_000_000},
+	}
+	for _, test := range invalid {
+		if _, err := ConvertMoney(test.units, test.nanos); err == nil {
+			t.Errorf("ConvertMoney(%d, %d) expected error, got nil", test.units, test.nanos)
+		}
+	}
+}
+
+func TestConvertBigFloat(t *testing.T) {
+	parse := func(s string) *big.Float {
+		f, _, err := big.ParseFloat(s, 10, 200, big.ToNearestEven)
+		if err != nil {
+			t.Fatalf("big.ParseFloat(%s) returned error: %v", s, err)
+		}
+		return f
+	}
+
+	tests := []struct {
+		input    *big.Float
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{parse("123.45"), RoundHalf, "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},
+		{parse("123.456"), RoundDown, "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},
+		{parse("123.456"), RoundHalf, "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"},
+		{parse("123.4500000001"), RoundUp, "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"},
+		{parse("1000000"), RoundHalf, "หนึ่งล้านบาทถ้วน"},
+		{parse("-5.5"), RoundHalf, "ลบห้าบาทห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input, test.mode)
+		if err != nil {
+			t.Errorf("Convert(big.Float %s, %v) returned error: %v", test.input.Text('f', -1), test.mode, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(big.Float %s, %v) = %s, expected %s", test.input.Text('f', -1), test.mode, result, test.expected)
+		}
+	}
+
+	// Long decimal strings keep all fractional digits for the rounding decision
+	result, err := Convert("0.1200000000000000001", RoundUp)
+	if err != nil {
+		t.Errorf("Convert with long decimal string returned error: %v", err)
+	}
+	if expected := "ศูนย์บาทสิบสามสตางค์"; result != expected {
+		t.Errorf("Convert with long decimal string = %s, expected %s", result, expected)
+	}
+
+	var nilFloat *big.Float
+	if _, err := Convert(nilFloat); err == nil {
+		t.Errorf("Convert(nil *big.Float) expected error, got nil")
+	}
+	if _, err := Convert(new(big.Float).SetInf(false)); err == nil {
+		t.Errorf("Convert(+Inf big.Float) expected error, got nil")
+	}
+}
+
+func TestConvertFraction(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{1, 3, RoundHalf, "ศูนย์บาทสามสิบสามสตางค์"}, // 0.333... -> 0.33
+		{2, 3, RoundHalf, "ศูนย์บาทหกสิบเจ็ดสตางค์"}, // 0.666... -> 0.67
+		{10, 4, RoundHalf, "สองบาทห้าสิบสตางค์"},     // 2.5
+		{1, 3, RoundUp, "ศูนย์บาทสามสิบสี่สตางค์"},   // 0.333... -> 0.34
+		{2, 3, RoundDown, "ศูนย์บาทหกสิบหกสตางค์"},   // 0.666... -> 0.66
+		{1, 200, RoundHalf, "ศูนย์บาทหนึ่งสตางค์"},   // 0.005 tie rounds up
+		{1, 201, RoundHalf, "ศูนย์บาทถ้วน"},          // 0.004975...
+		{1001, 1000, RoundUp, "หนึ่งบาทหนึ่งสตางค์"}, // 1.001 -> 1.01
+		{-10, 4, RoundHalf, "ลบสองบาทห้าสิบสตางค์"},  // -2.5
+		{10, -4, RoundHalf, "ลบสองบาทห้าสิบสตางค์"},  // -2.5
+		{0, -4, RoundHalf, "ศูนย์บาทถ้วน"},
+		{7, 1, RoundHalf, "เจ็ดบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertFraction(test.num, test.den, test.mode)
+		if err != nil {
+			t.Errorf("ConvertFraction(%d, %d) returned error: %v", test.num, test.den, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertFraction(%d, %d, %v) = %s, expected %s", test.num, test.den, test.mode, result, test.expected)
+		}
+	}
+
+	_, err := ConvertFraction(1, 0)
+	if convErr, ok := err.(*ConversionError); !ok || convErr.Code != ErrorCodeInvalidInput {
+		t.Errorf("ConvertFraction(1, 0) expected ErrorCodeInvalidInput, got %v", err)
+	}
+}
+
+func TestConvertFractionMinorUnitDigits(t *testing.T) {
+	tests := []struct {
+		digits   int
+		num, den int64
+		exact    string
+	}{
+		{3, 6, 10000, "0.0006"},
+		{3, 4, 10000, "0.0004"},
+		{3, 5, 10000, "0.0005"},
+		{4, 1, 3, "0.33333333"},
+		{6, 2, 3, "0.66666666"},
+		{6, 1, 2000000, "0.0000005"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{MinorUnitDigits: test.digits})
+		for _, mode := range []DecimalRoundingMode{RoundHalf, RoundDown, RoundUp} {
+			result, err := converter.ConvertFraction(test.num, test.den, mode)
+			if err != nil {
+				t.Errorf("ConvertFraction(%d, %d) with MinorUnitDigits %d returned error: %v", test.num, test.den, test.digits, err)
+				continue
+			}
+			if expected, _ := converter.Convert(test.exact, mode); result != expected {
+				t.Errorf("ConvertFraction(%d, %d, %v) with MinorUnitDigits %d = %s, expected %s", test.num, test.den, mode, test.digits, result, expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package thbtextizer
+
+import (
+	"encoding/json"
+)
+
+// ThaiAmount pairs an amount with its Thai reading for API responses. It
+// marshals as {"amount":"123.45","text":"..."}, filling in Text from Value
+// when Text is empty.
+type ThaiAmount struct {
+	Value string `json:"amount"`
+	Text  string `json:"text"`
+}
+
+// MarshalJSON encodes a, converting Value with RoundHalf when Text is empty
+func (a ThaiAmount) MarshalJSON() ([]byte, error) {
+	if a.Text == "" {
+		text, err := Convert(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		a.Text = text
+	}
+
+	// The alias drops the MarshalJSON method to avoid recursing into it
+	type plain ThaiAmount
+	return json.Marshal(plain(a))
+}
+
+// ToJSON rounds amount to satang and encodes it with its Thai reading as
+// {"amount":"123.45","text":"หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"}
+func ToJSON(amount any, roundingMode ...DecimalRoundingMode) ([]byte, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	opts := globalOptions()
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ThaiAmount{
+		Value: normalized.decimalString(),
+		Text:  renderAmount(normalized, opts),
+	})
+}
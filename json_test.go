@@ -0,0 +1,66 @@
+package thbtextizer
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestToJSON(t *testing.T) {
+	tests := []struct {
+		input    any
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{"123.45", RoundHalf, `{"amount":"123.45","text":"หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"}`},
+		{100, RoundHalf, `{"amount":"100.00","text":"หนึ่งร้อยบาทถ้วน"}`},
+		{"1,234.567", RoundDown, `{"amount":"1234.56","text":"หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"}`},
+		{"-0.5", RoundHalf, `{"amount":"-0.50","text":"ลบศูนย์บาทห้าสิบสตางค์"}`},
+	}
+
+	for _, test := range tests {
+		result, err := ToJSON(test.input, test.mode)
+		if err != nil {
+			t.Errorf("ToJSON(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if string(result) != test.expected {
+			t.Errorf("ToJSON(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := ToJSON("abc"); err == nil {
+		t.Errorf("ToJSON(abc) expected error, got nil")
+	}
+}
+
+func TestThaiAmountMarshalJSON(t *testing.T) {
+	type response struct {
+		Total ThaiAmount `json:"total"`
+	}
+
+	data, err := json.Marshal(response{Total: ThaiAmount{Value: "21"}})
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if expected := `{"total":{"amount":"21","text":"ยี่สิบเอ็ดบาทถ้วน"}}`; string(data) != expected {
+		t.Errorf("json.Marshal = %s, expected %s", data, expected)
+	}
+
+	// A preset Text is kept as is
+	data, _ = json.Marshal(ThaiAmount{Value: "21", Text: "custom"})
+	if expected := `{"amount":"21","text":"custom"}`; string(data) != expected {
+		t.Errorf("json.Marshal with Text = %s, expected %s", data, expected)
+	}
+
+	if _, err := json.Marshal(ThaiAmount{Value: "abc"}); err == nil {
+		t.Errorf("json.Marshal(ThaiAmount{abc}) expected error, got nil")
+	}
+
+	var decoded ThaiAmount
+	if err := json.Unmarshal([]byte(`{"amount":"1.50","text":"หนึ่งบาทห้าสิบสตางค์"}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if decoded.Value != "1.50" || decoded.Text != "หนึ่งบาทห้าสิบสตางค์" {
+		t.Errorf("json.Unmarshal = %+v", decoded)
+	}
+}
@@ -0,0 +1,82 @@
+// Package lao provides a Lao lexicon for thbtextizer. Lao shares the Thai
+// grouping rules, so the same number engine reads kip and att amounts once
+// the digit and unit words are swapped:
+//
+//	converter := thbtextizer.NewConverter(lao.NewConfig())
+//	converter.Convert("21") // ຊາວເອັດກີບຖ້ວນ
+package lao
+
+import (
+	thbtextizer "github.com/natt-v/thai-baht-textizer"
+)
+
+// Lexicon spells digits and units in Lao
+var Lexicon thbtextizer.Lexicon = lexicon{}
+
+var (
+	digits = [10]string{"ສູນ", "ໜຶ່ງ", "ສອງ", "ສາມ", "ສີ່", "ຫ້າ", "ຫົກ", "ເຈັດ", "ແປດ", "ເກົ້າ"}
+	units  = [7]string{"", "ສິບ", "ຮ້ອຍ", "ພັນ", "ໝື່ນ", "ແສນ", "ລ້ານ"}
+)
+
+type lexicon struct{}
+
+func (lexicon) Digit(d int) string {
+	return digits[d]
+}
+
+func (lexicon) Unit(place int) string {
+	return units[place]
+}
+
+// Tens reads 20 as "ຊາວ" rather than "ສອງສິບ"
+func (lexicon) Tens(d int) string {
+	switch d {
+	case 1:
+		return units[1]
+	case 2:
+		return "ຊາວ"
+	default:
+		return digits[d] + units[1]
+	}
+}
+
+func (lexicon) TrailingOne() string {
+	return "ເອັດ"
+}
+
+func (lexicon) Point() string {
+	return "ຈຸດ"
+}
+
+func (lexicon) Minus() string {
+	return "ລົບ"
+}
+
+func (lexicon) Plus() string {
+	return "ບວກ"
+}
+
+func (lexicon) Remainder() string {
+	return "ປາຍ"
+}
+
+func (lexicon) Percent() string {
+	return "ເປີເຊັນ"
+}
+
+func (lexicon) CheckCode() string {
+	return "ລະຫັດກວດສອບ"
+}
+
+// NewConfig returns a converter configuration that reads amounts in Lao
+// kip (ກີບ) and att (ອັດ) with RoundHalf rounding
+func NewConfig() *thbtextizer.Config {
+	return &thbtextizer.Config{
+		DefaultRounding: thbtextizer.RoundHalf,
+		Lexicon:         Lexicon,
+		MajorUnit:       "ກີບ",
+		MinorUnit:       "ອັດ",
+		EvenSuffix:      "ຖ້ວນ",
+		NegativePrefix:  "ລົບ",
+	}
+}
@@ -0,0 +1,118 @@
+package lao
+
+import (
+	"testing"
+
+	thbtextizer "github.com/natt-v/thai-baht-textizer"
+)
+
+func TestLaoReadings(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"0", "ສູນກີບຖ້ວນ"},
+		{"1", "ໜຶ່ງກີບຖ້ວນ"},
+		{"11", "ສິບເອັດກີບຖ້ວນ"},
+		{"20", "ຊາວກີບຖ້ວນ"},
+		{"21", "ຊາວເອັດກີບຖ້ວນ"},
+		{"123.45", "ໜຶ່ງຮ້ອຍຊາວສາມກີບສີ່ສິບຫ້າອັດ"},
+		{"0.01", "ສູນກີບໜຶ່ງອັດ"},
+		{"0.21", "ສູນກີບຊາວເອັດອັດ"},
+		{"150000", "ໜຶ່ງແສນຫ້າໝື່ນກີບຖ້ວນ"},
+		{"2000000", "ສອງລ້ານກີບຖ້ວນ"},
+		{"21000021", "ຊາວເອັດລ້ານຊາວເອັດກີບຖ້ວນ"},
+		{"-15", "ລົບສິບຫ້າກີບຖ້ວນ"},
+	}
+
+	converter := thbtextizer.NewConverter(NewConfig())
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// The package-level functions keep reading Thai
+	result, _ := thbtextizer.Convert("21")
+	if expected := "ยี่สิบเอ็ดบาทถ้วน"; result != expected {
+		t.Errorf("thbtextizer.Convert(21) = %s, expected %s", result, expected)
+	}
+}
+
+func TestLaoNumbers(t *testing.T) {
+	converter := thbtextizer.NewConverter(NewConfig())
+
+	if result, err := converter.ConvertNumber("21.5"); err != nil || result != "ຊາວເອັດຈຸດຫ້າ" {
+		t.Errorf("ConvertNumber(21.5) = %s, %v, expected ຊາວເອັດຈຸດຫ້າ", result, err)
+	}
+	if result, err := converter.ConvertNumber("-0.05"); err != nil || result != "ລົບສູນຈຸດສູນຫ້າ" {
+		t.Errorf("ConvertNumber(-0.05) = %s, %v, expected ລົບສູນຈຸດສູນຫ້າ", result, err)
+	}
+	if result, err := converter.ReadNumberWithUnit("100", " m"); err != nil || result != "ໜຶ່ງຮ້ອຍ m" {
+		t.Errorf("ReadNumberWithUnit(100, m) = %s, %v, expected ໜຶ່ງຮ້ອຍ m", result, err)
+	}
+	if result, err := converter.ConvertPercent("5%"); err != nil || result != "ຫ້າເປີເຊັນ" {
+		t.Errorf("ConvertPercent(5%%) = %s, %v, expected ຫ້າເປີເຊັນ", result, err)
+	}
+	if result, err := converter.ConvertWithCheck("21"); err != nil || result != "ຊາວເອັດກີບຖ້ວນ ລະຫັດກວດສອບ ສາມ" {
+		t.Errorf("ConvertWithCheck(21) = %s, %v, expected ຊາວເອັດກີບຖ້ວນ ລະຫັດກວດສອບ ສາມ", result, err)
+	}
+}
+
+func TestLaoFixedWords(t *testing.T) {
+	tests := []struct {
+		configure func(*thbtextizer.Config)
+		input     string
+		expected  string
+	}{
+		{func(c *thbtextizer.Config) { c.NegativePrefix = "" }, "-5", "ລົບຫ້າກີບຖ້ວນ"},
+		{func(c *thbtextizer.Config) { c.ShowPositivePrefix = true }, "5", "ບວກຫ້າກີບຖ້ວນ"},
+		{func(c *thbtextizer.Config) { c.SatangAsDecimal = true }, "5.25", "ຫ້າກີບຈຸດສອງຫ້າ"},
+		{func(c *thbtextizer.Config) { c.SatangStyle = thbtextizer.SatangRemainder }, "5.25", "ຫ້າກີບປາຍຊາວຫ້າອັດ"},
+	}
+
+	for _, test := range tests {
+		config := NewConfig()
+		test.configure(config)
+		result, err := thbtextizer.NewConverter(config).Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestLaoDigitWords(t *testing.T) {
+	config := NewConfig()
+	config.DigitWords = [10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	converter := thbtextizer.NewConverter(config)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// 10 and 20 keep the Lao forms when only the digits are replaced
+		{"10", "ສິບກີບຖ້ວນ"},
+		{"21", "ຊາວເອັດກີບຖ້ວນ"},
+		{"35", "3ສິບ5ກີບຖ້ວນ"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) with DigitWords returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with DigitWords = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package thbtextizer
+
+import "fmt"
+
+// Lexicon supplies the words the number engine reads digits with. The
+// engine handles grouping, zeros and the ล้าน boundaries; a Lexicon only
+// decides how each digit and unit, and the few fixed words read around
+// numbers, are spelled, so closely related languages such as Lao can reuse
+// the same rules.
+type Lexicon interface {
+	// Digit spells d from 0 to 9, where 0 is the word for zero
+	Digit(d int) string
+	// Unit spells the place value: 1 tens up to 5 hundred-thousands, and 6
+	// for the million boundary. Place 0 is the ones place and has no word.
+	Unit(place int) string
+	// Tens spells d from 1 to 9 in the tens place, unit included, which is
+	// where irregular forms such as "สิบ" and "ยี่สิบ" live
+	Tens(d int) string
+	// TrailingOne spells 1 in the ones place after higher digits, as "เอ็ด"
+	TrailingOne() string
+	// Point spells the decimal point before digits read one by one, as "จุด"
+	Point() string
+	// Minus spells the sign of negative amounts, as "ลบ"
+	Minus() string
+	// Plus spells the sign Config.ShowPositivePrefix reads, as "บวก"
+	Plus() string
+	// Remainder spells the "and some" word of SatangRemainder and
+	// ConvertApprox, as "เศษ"
+	Remainder() string
+	// Percent spells the unit ConvertPercent appends, as "เปอร์เซ็นต์"
+	Percent() string
+	// CheckCode spells the label before the digit ConvertWithCheck appends,
+	// as "รหัสตรวจสอบ"
+	CheckCode() string
+}
+
+// ThaiLexicon is the standard Thai lexicon used when Config.Lexicon is nil
+var ThaiLexicon Lexicon = thaiLexicon{}
+
+type thaiLexicon struct{}
+
+func (thaiLexicon) Digit(d int) string {
+	if d == 0 {
+		return "ศูนย์"
+	}
+	return digitNames[d]
+}
+
+func (thaiLexicon) Unit(place int) string {
+	return unitNames[place]
+}
+
+func (thaiLexicon) Tens(d int) string {
+	switch d {
+	case 1:
+		return unitNames[1]
+	case 2:
+		return "ยี่" + unitNames[1]
+	default:
+		return digitNames[d] + unitNames[1]
+	}
+}
+
+func (thaiLexicon) TrailingOne() string {
+	return "เอ็ด"
+}
+
+func (thaiLexicon) Point() string {
+	return "จุด"
+}
+
+func (thaiLexicon) Minus() string {
+	return "ลบ"
+}
+
+func (thaiLexicon) Plus() string {
+	return "บวก"
+}
+
+func (thaiLexicon) Remainder() string {
+	return "เศษ"
+}
+
+func (thaiLexicon) Percent() string {
+	return "เปอร์เซ็นต์"
+}
+
+func (thaiLexicon) CheckCode() string {
+	return "รหัสตรวจสอบ"
+}
+
+// vocabularyLexicon replaces the digit and unit words of a base lexicon with
+// Config.DigitWords and Config.UnitWords. An all-empty array keeps the base
+// words; NewConverter rejects partially filled ones.
+type vocabularyLexicon struct {
+	base   Lexicon
+	digits [10]string
+	units  [7]string
+}
+
+func (l vocabularyLexicon) Digit(d int) string {
+	if l.digits[d] == "" {
+		return l.base.Digit(d)
+	}
+	return l.digits[d]
+}
+
+func (l vocabularyLexicon) Unit(place int) string {
+	if l.units[1] == "" {
+		return l.base.Unit(place)
+	}
+	return l.units[place]
+}
+
+// Tens keeps the irregular forms of 10 and 20 from the base lexicon when
+// only the digit words are replaced. With replaced unit words 10 is the tens
+// unit alone and 20 is the Thai "ยี่" before it.
+func (l vocabularyLexicon) Tens(d int) string {
+	switch {
+	case (d == 1 || d == 2) && l.units[1] == "":
+		return l.base.Tens(d)
+	case d == 1:
+		return l.Unit(1)
+	case d == 2:
+		return "ยี่" + l.Unit(1)
+	default:
+		return l.Digit(d) + l.Unit(1)
+	}
+}
+
+func (l vocabularyLexicon) TrailingOne() string {
+	return l.base.TrailingOne()
+}
+
+func (l vocabularyLexicon) Point() string {
+	return l.base.Point()
+}
+
+func (l vocabularyLexicon) Minus() string {
+	return l.base.Minus()
+}
+
+func (l vocabularyLexicon) Plus() string {
+	return l.base.Plus()
+}
+
+func (l vocabularyLexicon) Remainder() string {
+	return l.base.Remainder()
+}
+
+func (l vocabularyLexicon) Percent() string {
+	return l.base.Percent()
+}
+
+func (l vocabularyLexicon) CheckCode() string {
+	return l.base.CheckCode()
+}
+
+// validateWords reports words arrays that are only partly filled. The ones
+// place, UnitWords[0], has no word and is not checked.
+func validateWords(digits [10]string, units [7]string) error {
+	arrays := []struct {
+		name  string
+		words []string
+	}{{"DigitWords", digits[:]}, {"UnitWords", units[1:]}}
+
+	for _, array := range arrays {
+		empty := 0
+		for _, word := range array.words {
+			if word == "" {
+				empty++
+			}
+		}
+		if empty != 0 && empty != len(array.words) {
+			return fmt.Errorf("%s has %d empty words; fill every word or none", array.name, empty)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package thbtextizer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorLanguageThai is the Config.ErrorLanguage value for Thai error messages
+const ErrorLanguageThai = "th"
+
+// localizeError rewrites the message and hint of a ConversionError in the
+// given language. Codes, inputs and wrapped causes are kept, and errors in
+// English or of other types are returned unchanged.
+func localizeError(err error, language string) error {
+	if language != ErrorLanguageThai {
+		return err
+	}
+
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) {
+		return err
+	}
+
+	localized := *convErr
+	switch convErr.Code {
+	case ErrorCodeUnsupportedType:
+		localized.Message = "ชนิดข้อมูลไม่รองรับ: รองรับเฉพาะ string, int, uint, float32, float64, *big.Float, json.Number และชนิดที่เกี่ยวข้อง"
+		localized.Hint = "แปลงข้อมูลเป็นชนิดที่รองรับก่อน"
+	case ErrorCodeExceedsMaxValue:
+		localized.Message = fmt.Sprintf("จำนวนเกินค่าสูงสุดที่รองรับคือ %s", convErr.limit)
+		localized.Hint = "ใช้จำนวนที่อยู่ในช่วงที่รองรับ"
+	case ErrorCodeInvalidInput:
+		localized.Message = fmt.Sprintf("ข้อมูลไม่ถูกต้อง: %q: %s", convErr.Input, fmt.Sprintf(convErr.reason.thai, convErr.reasonArgs...))
+		localized.Hint = "ตรวจสอบว่าข้อมูลมีเฉพาะตัวเลขที่ถูกต้อง"
+		if convErr.reason == reasonInsufficientPayment {
+			localized.Hint = "จ่ายเงินให้ไม่น้อยกว่าราคา"
+		}
+	case ErrorCodeParseError:
+		localized.Message = fmt.Sprintf("อ่านข้อความภาษาไทยไม่ได้: %q", convErr.Input)
+		localized.Hint = "ใช้ข้อความในรูปแบบที่ Convert สร้าง"
+	case ErrorCodeRoundingOccurred:
+		localized.Message = fmt.Sprintf("การปัดเศษทำให้ค่าเปลี่ยน: %s มีทศนิยมที่ไม่ใช่ศูนย์เกิน %d ตำแหน่ง", convErr.Input, convErr.places)
+		localized.Hint = "ปัดจำนวนเป็นสตางค์ก่อนแปลง หรือปิด ErrorOnRounding"
+	case ErrorCodeSatangCapped:
+		localized.Message = fmt.Sprintf("สตางค์ถูกจำกัดไว้ที่ 99: %s ปัดแล้วจะเกินเป็นบาทถัดไป", convErr.Input)
+		localized.Hint = "ตรวจสอบจำนวน หรือเปิด AllowOverflow"
+	case ErrorCodeCanceled:
+		localized.Message = fmt.Sprintf("การแปลงถูกยกเลิก: %v", convErr.Err)
+		localized.Hint = ""
+	default:
+		return err
+	}
+	return &localized
+}
+
+// invalidReason is why an ErrorCodeInvalidInput error rejected its input,
+// as English and Thai fmt templates filled with the same arguments
+type invalidReason struct {
+	english string
+	thai    string
+}
+
+// invalidReasons lists every reason declared with newReason
+var invalidReasons []invalidReason
+
+// newReason declares a reason with its translation, so a reworded reason
+// cannot silently lose it
+func newReason(english, thai string) invalidReason {
+	reason := invalidReason{english: english, thai: thai}
+	invalidReasons = append(invalidReasons, reason)
+	return reason
+}
+
+// The reasons of ErrorCodeInvalidInput errors
+var (
+	reasonEmptyInput         = newReason("empty input", "ไม่มีข้อมูล")
+	reasonInvalidCharacter   = newReason("invalid character '%c' (%U) at position %d", "อักขระ '%c' (%U) ที่ตำแหน่ง %d ไม่ถูกต้อง")
+	reasonSignPosition       = newReason("sign must appear only at the start", "เครื่องหมายบวกหรือลบต้องอยู่หน้าสุดเท่านั้น")
+	reasonMultiplePoints     = newReason("multiple decimal points", "มีจุดทศนิยมมากกว่าหนึ่งจุด")
+	reasonCommaAfterPoint    = newReason("comma after decimal point", "มีจุลภาคหลังจุดทศนิยม")
+	reasonSameSeparators     = newReason("decimal and thousand separators must differ, both are %q", "ตัวคั่นทศนิยมและตัวคั่นหลักพันต้องต่างกัน แต่เป็น %q ทั้งคู่")
+	reasonAmbiguousSeparator = newReason("ambiguous separator %q, expected decimal separator %q and thousand separator %q", "ตัวคั่น %q กำกวม ต้องใช้ %q คั่นทศนิยมและ %q คั่นหลักพัน")
+	reasonMalformedGrouping  = newReason("malformed digit grouping: group %d has %d digits, expected 3", "การคั่นหลักไม่ถูกต้อง: กลุ่มที่ %d มี %d หลัก ต้องมี 3 หลัก")
+	reasonStrictParsing      = newReason("strict parsing expects digits with an optional minus sign, grouping commas and decimal point", "โหมดตรวจเข้มรับเฉพาะตัวเลข เครื่องหมายลบ จุลภาคคั่นหลัก และจุดทศนิยม")
+	reasonInvalidExponent    = newReason("invalid exponent", "เลขชี้กำลังไม่ถูกต้อง")
+	reasonExponentRange      = newReason("exponent must be between -%d and %d", "เลขชี้กำลังต้องอยู่ระหว่าง -%d ถึง %d")
+	reasonInvalidMantissa    = newReason("invalid mantissa", "ตัวเลขหน้าเลขชี้กำลังไม่ถูกต้อง")
+	reasonInfinite           = newReason("infinite value", "ค่าเป็นอนันต์")
+	reasonNilBigFloat        = newReason("nil *big.Float", "*big.Float เป็น nil")
+	reasonNilUnscaled        = newReason("nil unscaled value", "ค่า unscaled เป็น nil")
+	reasonCannotParse        = newReason("cannot parse amount", "อ่านจำนวนไม่ได้")
+	reasonZeroDenominator    = newReason("zero denominator", "ตัวส่วนเป็นศูนย์")
+	reasonNanosRange         = newReason("nanos must be between -999,999,999 and +999,999,999", "nanos ต้องอยู่ระหว่าง -999,999,999 ถึง +999,999,999")
+	reasonNanosSign          = newReason("nanos must have the same sign as units", "nanos ต้องมีเครื่องหมายเดียวกับ units")
+	reasonTieBreaker         = newReason("Config.TieBreaker returned %d for %d, expected %d or %d", "Config.TieBreaker คืนค่า %d สำหรับ %d ต้องเป็น %d หรือ %d")
+
+	reasonExpectedMagnitude = newReason("expected a number or a Thai magnitude word at %s", "ต้องเป็นตัวเลขหรือคำบอกหลักภาษาไทยที่ %s")
+	reasonInvalidNumber     = newReason("invalid number %s", "ตัวเลข %s ไม่ถูกต้อง")
+	reasonMustFollowNumber  = newReason("%s must follow a number", "%s ต้องตามหลังตัวเลข")
+	reasonMagnitudeOrder    = newReason("magnitude word %s out of order", "คำบอกหลัก %s อยู่ผิดลำดับ")
+
+	reasonCountWhole          = newReason("count must be a whole number", "จำนวนนับต้องเป็นจำนวนเต็ม")
+	reasonCountNegative       = newReason("count must not be negative", "จำนวนนับต้องไม่ติดลบ")
+	reasonSatangRange         = newReason("satang must be between 0 and 99", "สตางค์ต้องอยู่ระหว่าง 0 ถึง 99")
+	reasonSignificantGroups   = newReason("significantGroups must be at least 1", "significantGroups ต้องมีค่าอย่างน้อย 1")
+	reasonNegativePaid        = newReason("paid amount must not be negative", "จำนวนที่จ่ายต้องไม่ติดลบ")
+	reasonNegativePrice       = newReason("price must not be negative", "ราคาต้องไม่ติดลบ")
+	reasonInsufficientPayment = newReason("insufficient payment: paid amount is less than price", "จ่ายไม่พอ: จำนวนที่จ่ายน้อยกว่าราคา")
+
+	reasonMinorDigitsRange      = newReason("minor unit digits must be between 1 and 6", "จำนวนหลักของหน่วยย่อยต้องอยู่ระหว่าง 1 ถึง 6")
+	reasonCashIncrementPositive = newReason("cash rounding increment must be positive", "ค่าปัดเศษเงินสดต้องมากกว่าศูนย์")
+	reasonCashIncrementPlaces   = newReason("cash rounding increment must have at most two decimal places", "ค่าปัดเศษเงินสดต้องมีทศนิยมไม่เกินสองตำแหน่ง")
+	reasonCashMinorDigits       = newReason("cash rounding requires two minor unit digits", "การปัดเศษเงินสดต้องใช้หน่วยย่อยสองหลัก")
+	reasonUnknownModeName       = newReason("unknown rounding mode, expected half, down, up, ceil, floor, halfup or halfawayfromzero", "ไม่รู้จักวิธีปัดเศษ ต้องเป็น half, down, up, ceil, floor, halfup หรือ halfawayfromzero")
+	reasonUnknownModeNumber     = newReason("unknown rounding mode, expected 0 (half) through 5 (half up)", "ไม่รู้จักวิธีปัดเศษ ต้องเป็น 0 (half) ถึง 5 (half up)")
+
+	reasonEmptyCurrencyCode  = newReason("currency code must not be empty", "รหัสสกุลเงินต้องไม่ว่าง")
+	reasonEmptyCurrencyWords = newReason("currency unit and subunit words must not be empty", "คำเรียกหน่วยและหน่วยย่อยของสกุลเงินต้องไม่ว่าง")
+	reasonDuplicateCurrency  = newReason("duplicate currency code %q", "รหัสสกุลเงิน %q ซ้ำ")
+	reasonNotStruct          = newReason("ConvertStruct requires a struct or a pointer to one", "ConvertStruct ต้องใช้ struct หรือ pointer ไปยัง struct")
+	reasonUnexportedTag      = newReason("thbtext tag on unexported field", "ใช้แท็ก thbtext กับฟิลด์ที่ไม่ได้ export")
+	reasonUnknownTagOption   = newReason("unknown thbtext tag option %q on field %s", "ไม่รู้จักตัวเลือกแท็ก thbtext %q ในฟิลด์ %s")
+	reasonNotTIS620          = newReason("character '%c' at position %d is not representable in TIS-620", "อักขระ '%c' ที่ตำแหน่ง %d เข้ารหัสเป็น TIS-620 ไม่ได้")
+)
@@ -0,0 +1,106 @@
+package thbtextizer
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestThaiErrorMessages(t *testing.T) {
+	converter := NewConverter(&Config{ErrorLanguage: ErrorLanguageThai, ErrorOnRounding: true})
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		convert func() error
+		code    ErrorCode
+		message string
+	}{
+		{"unsupported type", func() error { _, err := converter.Convert([]int{1}); return err }, ErrorCodeUnsupportedType, "ชนิดข้อมูลไม่รองรับ"},
+		{"exceeds max", func() error { _, err := converter.Convert("99999999999999999999"); return err }, ErrorCodeExceedsMaxValue, "จำนวนเกินค่าสูงสุดที่รองรับ"},
+		{"invalid input", func() error { _, err := converter.Convert("abc"); return err }, ErrorCodeInvalidInput, "ข้อมูลไม่ถูกต้อง"},
+		{"rounding", func() error { _, err := converter.Convert("1.005"); return err }, ErrorCodeRoundingOccurred, "การปัดเศษทำให้ค่าเปลี่ยน"},
+		{"canceled", func() error { _, err := converter.ConvertContext(canceled, "1"); return err }, ErrorCodeCanceled, "การแปลงถูกยกเลิก"},
+	}
+
+	for _, test := range tests {
+		err := test.convert()
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("%s: expected ConversionError, got %v", test.name, err)
+			continue
+		}
+		if convErr.Code != test.code {
+			t.Errorf("%s: code = %d, expected %d", test.name, convErr.Code, test.code)
+		}
+		if !strings.HasPrefix(convErr.Message, test.message) {
+			t.Errorf("%s: message = %s, expected prefix %s", test.name, convErr.Message, test.message)
+		}
+	}
+
+	// Parse errors are localized by code as well
+	if err := localizeError(newParseError("x", "bad"), ErrorLanguageThai); !strings.HasPrefix(err.Error(), "อ่านข้อความภาษาไทยไม่ได้") {
+		t.Errorf("localized parse error = %v", err)
+	}
+
+	// Canceled errors still wrap the context error
+	_, err := converter.ConvertContext(canceled, "1")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("localized canceled error %v does not wrap context.Canceled", err)
+	}
+}
+
+func TestThaiInvalidInputReasons(t *testing.T) {
+	converter := NewConverter(&Config{ErrorLanguage: ErrorLanguageThai, ValidateGrouping: true})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"12a4", `ข้อมูลไม่ถูกต้อง: "12a4": อักขระ 'a' (U+0061) ที่ตำแหน่ง 2 ไม่ถูกต้อง`},
+		{"1.2.3", `ข้อมูลไม่ถูกต้อง: "1.2.3": มีจุดทศนิยมมากกว่าหนึ่งจุด`},
+		{"", `ข้อมูลไม่ถูกต้อง: "": ไม่มีข้อมูล`},
+		{"1,00,000", `ข้อมูลไม่ถูกต้อง: "1,00,000": การคั่นหลักไม่ถูกต้อง: กลุ่มที่ 2 มี 2 หลัก ต้องมี 3 หลัก`},
+	}
+
+	for _, test := range tests {
+		_, err := converter.Convert(test.input)
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("Convert(%q) expected ConversionError, got %v", test.input, err)
+			continue
+		}
+		if convErr.Message != test.expected {
+			t.Errorf("Convert(%q) message = %s, expected %s", test.input, convErr.Message, test.expected)
+		}
+	}
+
+}
+
+func TestInvalidReasonTranslations(t *testing.T) {
+	verbs := regexp.MustCompile(`%[a-zA-Z]`)
+	for _, reason := range invalidReasons {
+		if reason.thai == "" {
+			t.Errorf("reason %q has no Thai template", reason.english)
+			continue
+		}
+		english := verbs.FindAllString(reason.english, -1)
+		thai := verbs.FindAllString(reason.thai, -1)
+		if !slices.Equal(english, thai) {
+			t.Errorf("reason %q takes %v, but its Thai template %q takes %v", reason.english, english, reason.thai, thai)
+		}
+	}
+}
+
+func TestDefaultErrorLanguage(t *testing.T) {
+	for _, config := range []*Config{{}, {ErrorLanguage: "en"}} {
+		_, err := NewConverter(config).Convert("abc")
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid input") {
+			t.Errorf("Convert(abc) with ErrorLanguage %q = %v, expected English message", config.ErrorLanguage, err)
+		}
+	}
+}
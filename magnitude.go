@@ -0,0 +1,113 @@
+package thbtextizer
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// magnitudeWords maps the Thai magnitude words accepted in numeric input by
+// Config.ExpandMagnitudeWords to their powers of ten, built from unitNames
+var magnitudeWords = map[string]int{}
+
+func init() {
+	for index := 2; index < len(unitNames); index++ {
+		magnitudeWords[unitNames[index]] = index
+	}
+}
+
+// expandMagnitudeWords rewrites numeric input that uses Thai magnitude words,
+// such as "5แสน" or "2ล้าน5แสน", as a plain decimal string. Each word
+// multiplies the number before it; "ล้าน" multiplies everything read since
+// the previous "ล้าน", so "1แสนล้าน" is 10^11. Within a ล้าน group words must
+// descend. Input without magnitude words is returned unchanged.
+func expandMagnitudeWords(input string) (string, error) {
+	if !containsMagnitudeWord(input) {
+		return input, nil
+	}
+
+	text := strings.Join(strings.Fields(input), "")
+	// The sign is kept as written, so a "+" is still checked by
+	// StrictParsing like plain input
+	sign := ""
+	if strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") {
+		sign, text = text[:1], text[1:]
+	}
+
+	million := new(big.Rat).SetInt64(pow10(6))
+	total := new(big.Rat)
+	group := new(big.Rat)
+	lastPower := 6 // the previous word in this group
+	places := 0    // decimals needed to print the result exactly
+
+	for text != "" {
+		numberEnd := strings.IndexFunc(text, func(r rune) bool {
+			return !unicode.IsDigit(r) && r != '.' && r != ','
+		})
+		if numberEnd < 0 {
+			numberEnd = len(text)
+		}
+		numberText, rest := text[:numberEnd], text[numberEnd:]
+
+		word, power := "", 0
+		for candidate, candidatePower := range magnitudeWords {
+			if strings.HasPrefix(rest, candidate) {
+				word, power = candidate, candidatePower
+				break
+			}
+		}
+		if word == "" && rest != "" {
+			return "", newInvalidInputError(input, reasonExpectedMagnitude, rest)
+		}
+		text = strings.TrimPrefix(rest, word)
+
+		value := new(big.Rat)
+		if numberText != "" {
+			number := strings.ReplaceAll(strings.Map(normalizeThaiDigit, numberText), ",", "")
+			integerPart, fractionPart, _ := strings.Cut(number, ".")
+			if !isValidNumber(integerPart+fractionPart) || strings.Count(number, ".") > 1 {
+				return "", newInvalidInputError(input, reasonInvalidNumber, numberText)
+			}
+			value.SetString(number)
+			places = max(places, len(fractionPart))
+		}
+
+		switch {
+		case word == "":
+			// A trailing number without a word is read in the ones place
+			group.Add(group, value)
+		case power == 6:
+			if numberText == "" && group.Sign() == 0 && total.Sign() == 0 {
+				return "", newInvalidInputError(input, reasonMustFollowNumber, "ล้าน")
+			}
+			group.Add(group, value)
+			total.Add(total, group)
+			total.Mul(total, million)
+			group.SetInt64(0)
+			lastPower = 6
+		default:
+			if numberText == "" {
+				return "", newInvalidInputError(input, reasonMustFollowNumber, word)
+			}
+			if power >= lastPower {
+				return "", newInvalidInputError(input, reasonMagnitudeOrder, word)
+			}
+			value.Mul(value, new(big.Rat).SetInt64(pow10(power)))
+			group.Add(group, value)
+			lastPower = power
+		}
+	}
+
+	total.Add(total, group)
+	return sign + total.FloatString(places), nil
+}
+
+// containsMagnitudeWord reports whether input holds any Thai magnitude word
+func containsMagnitudeWord(input string) bool {
+	for word := range magnitudeWords {
+		if strings.Contains(input, word) {
+			return true
+		}
+	}
+	return false
+}
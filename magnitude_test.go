@@ -0,0 +1,83 @@
+package thbtextizer
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExpandMagnitudeWords(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"5แสน", "500000"},
+		{"123ล้าน", "123000000"},
+		{"5 แสน", "500000"},
+		{"2ล้าน5แสน", "2500000"},
+		{"1.5ล้าน", "1500000.0"},
+		{"1แสนล้าน", "100000000000"},
+		{"3ล้านล้าน", "3000000000000"},
+		{"2พัน350", "2350"},
+		{"-1,500ล้าน", "-1500000000"},
+		{"+1.5ล้าน", "+1500000.0"},
+		{"๕หมื่น", "50000"},
+		{"1ร้อย25.50", "125.50"},
+		{"123.45", "123.45"},
+	}
+
+	for _, test := range tests {
+		result, err := expandMagnitudeWords(test.input)
+		if err != nil {
+			t.Errorf("expandMagnitudeWords(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("expandMagnitudeWords(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	for _, input := range []string{"แสน", "5พัน3แสน", "5แสนบาท", "1.2.3ล้าน", "5แสน-3"} {
+		if _, err := expandMagnitudeWords(input); err == nil {
+			t.Errorf("expandMagnitudeWords(%s) expected error, got nil", input)
+		}
+	}
+}
+
+func TestConvertExpandMagnitudeWords(t *testing.T) {
+	converter := NewConverter(&Config{ExpandMagnitudeWords: true})
+
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"5แสน", "ห้าแสนบาทถ้วน"},
+		{"2ล้าน5แสน", "สองล้านห้าแสนบาทถ้วน"},
+		{"1.25พัน", "หนึ่งพันสองร้อยห้าสิบบาทถ้วน"},
+		{"+1.5ล้าน", "หนึ่งล้านห้าแสนบาทถ้วน"},
+		{"-1.5ล้าน", "ลบหนึ่งล้านห้าแสนบาทถ้วน"},
+		{"123.45", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},
+		{500, "ห้าร้อยบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// A "+" is checked by StrictParsing as in plain input
+	strict := NewConverter(&Config{ExpandMagnitudeWords: true, StrictParsing: true})
+	if _, err := strict.Convert("+1.5ล้าน"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Convert(+1.5ล้าน) with StrictParsing error = %v, expected invalid input", err)
+	}
+
+	// The words are only read when the option is set
+	if _, err := Convert("5แสน"); err == nil {
+		t.Errorf("Convert(5แสน) without ExpandMagnitudeWords expected error, got nil")
+	}
+}
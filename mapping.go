@@ -0,0 +1,113 @@
+package thbtextizer
+
+import "strings"
+
+// Segment is a piece of a reading linked to the digits it reads. Start and
+// End delimit those digits in the normalized value returned by
+// ConvertWithValue, such as "-123.45", so value[Start:End] produced Text.
+// Words that read no digit of their own, such as "บาท" and "ล้าน", have
+// Start and End set to -1.
+type Segment struct {
+	Text  string
+	Start int
+	End   int
+}
+
+// ConvertWithMapping converts amount like Convert but returns the reading as
+// segments linked to the digits they read, so a UI can highlight the words
+// of each typed digit. Joining the segment texts gives the Convert reading:
+//
+//	"123" -> [{หนึ่งร้อย 0 1} {ยี่สิบ 1 2} {สาม 2 3} {บาท -1 -1} {ถ้วน -1 -1}]
+func ConvertWithMapping(amount any, roundingMode ...DecimalRoundingMode) ([]Segment, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertWithMapping(amount, mode, globalOptions())
+}
+
+func convertWithMapping(amount any, mode DecimalRoundingMode, opts convertOptions) ([]Segment, error) {
+	n, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	lex := opts.words()
+	units := opts.units()
+	segments := make([]Segment, 0, 16)
+
+	// Positions follow n.decimalString(): an optional sign, the integer
+	// digits without leading zeros, a point and two satang digits
+	offset := 0
+	if n.negative {
+		segments = append(segments, Segment{Text: opts.minusWord(), Start: 0, End: 1})
+		offset = 1
+	}
+
+	integer := strings.TrimLeft(n.integer, "0")
+	if integer == "" {
+		segments = append(segments, Segment{Text: lex.Digit(0), Start: offset, End: offset + 1})
+		integer = "0"
+	} else {
+		segments = appendIntegerSegments(segments, lex, parseDigits(integer), offset)
+	}
+	segments = append(segments, wordSegment(units.Unit))
+
+	if n.isEven() {
+		return append(segments, wordSegment(units.Even)), nil
+	}
+
+	satangStart := offset + len(integer) + 1
+	tens, ones := int(n.decimal[0]-'0'), int(n.decimal[1]-'0')
+	if tens > 0 {
+		segments = append(segments, Segment{Text: lex.Tens(tens), Start: satangStart, End: satangStart + 1})
+	}
+	switch {
+	case ones == 1 && tens > 0:
+		segments = append(segments, Segment{Text: lex.TrailingOne(), Start: satangStart + 1, End: satangStart + 2})
+	case ones > 0:
+		segments = append(segments, Segment{Text: lex.Digit(ones), Start: satangStart + 1, End: satangStart + 2})
+	}
+
+	return append(segments, wordSegment(units.SubUnit)), nil
+}
+
+// appendIntegerSegments appends one segment per non-zero digit and one per
+// "ล้าน", following the grouping rules of buildThaiText
+func appendIntegerSegments(segments []Segment, lex Lexicon, digits []int, offset int) []Segment {
+	seenNonZero := false
+
+	// Groups run left to right; the first one may be shorter than six digits
+	groupEnd := len(digits) % 6
+	if groupEnd == 0 {
+		groupEnd = 6
+	}
+	for groupStart := 0; groupStart < len(digits); groupStart, groupEnd = groupEnd, groupEnd+6 {
+		group := digits[groupStart:groupEnd]
+		groupsFromRight := (len(digits) - groupEnd) / 6
+
+		for position, digit := range group {
+			if digit == 0 {
+				continue
+			}
+			positionFromRight := len(group) - position - 1
+			text := convertDigitAtPosition(lex, digit, positionFromRight%6, positionFromRight, len(group))
+			index := offset + groupStart + position
+			segments = append(segments, Segment{Text: text, Start: index, End: index + 1})
+			seenNonZero = true
+		}
+
+		// Every boundary after the first non-zero digit reads one "ล้าน"
+		if groupsFromRight > 0 && seenNonZero {
+			segments = append(segments, wordSegment(lex.Unit(6)))
+		}
+	}
+
+	return segments
+}
+
+// wordSegment returns a segment for a word that reads no digit
+func wordSegment(text string) Segment {
+	return Segment{Text: text, Start: -1, End: -1}
+}
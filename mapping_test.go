@@ -0,0 +1,70 @@
+package thbtextizer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConvertWithMapping(t *testing.T) {
+	segments, err := ConvertWithMapping("123")
+	if err != nil {
+		t.Fatalf("ConvertWithMapping(123) returned error: %v", err)
+	}
+
+	expected := []Segment{
+		{"หนึ่งร้อย", 0, 1},
+		{"ยี่สิบ", 1, 2},
+		{"สาม", 2, 3},
+		{"บาท", -1, -1},
+		{"ถ้วน", -1, -1},
+	}
+	if !reflect.DeepEqual(segments, expected) {
+		t.Errorf("ConvertWithMapping(123) = %v, expected %v", segments, expected)
+	}
+
+	segments, err = ConvertWithMapping("-1,000,021.51")
+	if err != nil {
+		t.Fatalf("ConvertWithMapping(-1,000,021.51) returned error: %v", err)
+	}
+	expected = []Segment{
+		{"ลบ", 0, 1},
+		{"หนึ่ง", 1, 2},
+		{"ล้าน", -1, -1},
+		{"ยี่สิบ", 6, 7},
+		{"เอ็ด", 7, 8},
+		{"บาท", -1, -1},
+		{"ห้าสิบ", 9, 10},
+		{"เอ็ด", 10, 11},
+		{"สตางค์", -1, -1},
+	}
+	if !reflect.DeepEqual(segments, expected) {
+		t.Errorf("ConvertWithMapping(-1,000,021.51) = %v, expected %v", segments, expected)
+	}
+}
+
+func TestConvertWithMappingMatchesConvert(t *testing.T) {
+	for _, tc := range convertTestCases {
+		segments, err := ConvertWithMapping(tc.input)
+		if err != nil {
+			t.Errorf("ConvertWithMapping(%s) returned error: %v", tc.input, err)
+			continue
+		}
+		_, value, _ := ConvertWithValue(tc.input)
+
+		var text strings.Builder
+		for _, segment := range segments {
+			text.WriteString(segment.Text)
+			if segment.Start >= 0 && strings.Trim(value[segment.Start:segment.End], "0123456789-") != "" {
+				t.Errorf("ConvertWithMapping(%s) segment %v does not point at digits of %s", tc.input, segment, value)
+			}
+		}
+		if text.String() != tc.expected {
+			t.Errorf("ConvertWithMapping(%s) joins to %s, expected %s", tc.input, text.String(), tc.expected)
+		}
+	}
+
+	if _, err := ConvertWithMapping("abc"); err == nil {
+		t.Error("ConvertWithMapping(abc) expected error, got nil")
+	}
+}
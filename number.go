@@ -0,0 +1,198 @@
+package thbtextizer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ReadNumberWithUnit reads amount as a plain Thai number followed by unit,
+// for measurements rather than money: the integer part is spelled out and any
+// decimals are read digit by digit after "จุด".
+//
+//	ReadNumberWithUnit("2.5", "กิโลกรัม") -> "สองจุดห้ากิโลกรัม"
+//	ReadNumberWithUnit("100", "เมตร")     -> "หนึ่งร้อยเมตร"
+func ReadNumberWithUnit(amount any, unit string) (string, error) {
+	return readNumberWithUnit(amount, unit, globalOptions())
+}
+
+// ReadNumberWithUnit reads amount as a plain Thai number followed by unit
+// using instance configuration
+func (c *Converter) ReadNumberWithUnit(amount any, unit string) (string, error) {
+	return readNumberWithUnit(amount, unit, c.config.options())
+}
+
+// ConvertNumber reads amount as a plain Thai number without any currency
+// words, for quantities rather than money. Decimals are read digit by digit
+// after "จุด" and are never rounded. Zeros in the decimals are read as
+// written, so 3.10 reads "สามจุดหนึ่งศูนย์"; set Config.TrimFractionZeros
+// on a Converter to read it as "สามจุดหนึ่ง":
+//
+//	ConvertNumber("123.45") -> "หนึ่งร้อยยี่สิบสามจุดสี่ห้า"
+//	ConvertNumber("3.05")   -> "สามจุดศูนย์ห้า"
+func ConvertNumber(amount any) (string, error) {
+	return readNumber(amount, globalOptions())
+}
+
+// ConvertNumber reads amount as a plain Thai number using instance
+// configuration
+func (c *Converter) ConvertNumber(amount any) (string, error) {
+	return readNumber(amount, c.config.options())
+}
+
+// ConvertPercent reads amount as a percentage. A trailing "%" on string
+// input is accepted and read as "เปอร์เซ็นต์":
+//
+//	ConvertPercent("12.5%") -> "สิบสองจุดห้าเปอร์เซ็นต์"
+//	ConvertPercent(100)     -> "หนึ่งร้อยเปอร์เซ็นต์"
+func ConvertPercent(amount any) (string, error) {
+	return convertPercent(amount, globalOptions())
+}
+
+// ConvertPercent reads amount as a percentage using instance configuration
+func (c *Converter) ConvertPercent(amount any) (string, error) {
+	return convertPercent(amount, c.config.options())
+}
+
+func convertPercent(amount any, opts convertOptions) (string, error) {
+	if str, ok := amount.(string); ok {
+		amount = strings.TrimSuffix(strings.TrimSpace(str), "%")
+	}
+	return readNumberWithUnit(amount, opts.words().Percent(), opts)
+}
+
+func readNumberWithUnit(amount any, unit string, opts convertOptions) (string, error) {
+	text, err := readNumber(amount, opts)
+	if err != nil {
+		return "", err
+	}
+	return text + unit, nil
+}
+
+// ConvertInteger spells only the whole-number part of amount, without "บาท"
+// or satang, for fields that print the currency word separately. Decimals are
+// truncated, not rounded:
+//
+//	ConvertInteger("1234.99") -> "หนึ่งพันสองร้อยสามสิบสี่"
+//	ConvertInteger("-21")     -> "ลบยี่สิบเอ็ด"
+func ConvertInteger(amount any) (string, error) {
+	normalized, err := normalizeAmount(amount, RoundDown, globalOptions())
+	if err != nil {
+		return "", err
+	}
+
+	integerText := convertIntegerNumber(normalized.integer, globalOptions())
+	if integerText == "" {
+		return "ศูนย์", nil
+	}
+	if normalized.negative {
+		return "ลบ" + integerText, nil
+	}
+	return integerText, nil
+}
+
+// ConvertCount spells a whole count followed by a classifier word, for
+// inventory and other quantities that are not money. Negative and fractional
+// counts return an ErrorCodeInvalidInput error; zero decimals such as "23.00"
+// are accepted:
+//
+//	ConvertCount(23, "ชิ้น")    -> "ยี่สิบสามชิ้น"
+//	ConvertCount("1,000", "คน") -> "หนึ่งพันคน"
+func ConvertCount(n any, classifier string) (string, error) {
+	amountStr, negative, err := validateAmount(n)
+	if err != nil {
+		return "", err
+	}
+
+	integer, fraction, _ := strings.Cut(amountStr, ".")
+	if strings.Trim(fraction, "0") != "" {
+		return "", newInvalidInputError(amountStr, reasonCountWhole)
+	}
+	if negative && strings.Trim(integer, "0") != "" {
+		return "", newInvalidInputError("-"+amountStr, reasonCountNegative)
+	}
+
+	integerText := convertIntegerNumber(integer, convertOptions{})
+	if integerText == "" {
+		integerText = "ศูนย์"
+	}
+	return integerText + classifier, nil
+}
+
+// ConvertSatang spells a satang value from 0 to 99 without "สตางค์", using
+// the same reading as the satang part of Convert. Values outside the range
+// return an ErrorCodeInvalidInput error.
+func ConvertSatang(satang int) (string, error) {
+	if satang < 0 || satang > 99 {
+		return "", newInvalidInputError(strconv.Itoa(satang), reasonSatangRange)
+	}
+	if satang == 0 {
+		return "ศูนย์", nil
+	}
+	return convertDecimalPart(fmt.Sprintf("%02d", satang)), nil
+}
+
+// readNumber spells amount as a Thai number without currency words
+func readNumber(amount any, opts convertOptions) (string, error) {
+	if s, ok := amount.(string); ok {
+		s, err := preprocessInput(s, opts)
+		if err != nil {
+			return "", localizeError(err, opts.errorLanguage)
+		}
+		amount = s
+	}
+
+	amountStr, err := convertToString(amount)
+	if err != nil {
+		return "", localizeError(err, opts.errorLanguage)
+	}
+
+	amountStr, negative, err := sanitizeInput(amountStr)
+	if err != nil {
+		return "", localizeError(err, opts.errorLanguage)
+	}
+	amountStr = strings.ReplaceAll(amountStr, ",", "")
+
+	if err := validateMaxValue(amountStr); err != nil {
+		return "", localizeError(err, opts.errorLanguage)
+	}
+	if opts.maxValue != "" && exceedsLimit(amountStr, opts.maxValue) {
+		return "", localizeError(newExceedsLimitError(amountStr, opts.maxValue), opts.errorLanguage)
+	}
+
+	parts := strings.Split(amountStr, ".")
+	if len(parts) > 1 && opts.trimFractionZeros {
+		parts[1] = strings.TrimRight(parts[1], "0")
+	}
+
+	var builder strings.Builder
+	builder.Grow(64)
+
+	lex := opts.words()
+	if negative && strings.Trim(strings.Replace(amountStr, ".", "", 1), "0") != "" {
+		builder.WriteString(opts.minusWord())
+	}
+
+	integerText := convertIntegerNumber(parts[0], opts)
+	if integerText == "" {
+		integerText = lex.Digit(0)
+	}
+	builder.WriteString(integerText)
+
+	if len(parts) > 1 && parts[1] != "" {
+		builder.WriteString(lex.Point())
+		builder.WriteString(readDigits(lex, parts[1]))
+	}
+
+	return builder.String(), nil
+}
+
+// readDigits reads each digit of str individually with lex, as after the
+// point in 3.14
+func readDigits(lex Lexicon, str string) string {
+	var builder strings.Builder
+	for _, char := range str {
+		builder.WriteString(lex.Digit(int(char - '0')))
+	}
+	return builder.String()
+}
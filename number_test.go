@@ -0,0 +1,303 @@
+package thbtextizer
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReadNumberWithUnit(t *testing.T) {
+	tests := []struct {
+		input    any
+		unit     string
+		expected string
+	}{
+		{"2.5", "กิโลกรัม", "สองจุดห้ากิโลกรัม"},
+		{"100", "เมตร", "หนึ่งร้อยเมตร"},
+		{"0.75", "ลิตร", "ศูนย์จุดเจ็ดห้าลิตร"},
+		{"1,500.05", "กรัม", "หนึ่งพันห้าร้อยจุดศูนย์ห้ากรัม"},
+		{21, "องศา", "ยี่สิบเอ็ดองศา"},
+		{"3.14", "", "สามจุดหนึ่งสี่"},
+	}
+
+	for _, test := range tests {
+		result, err := ReadNumberWithUnit(test.input, test.unit)
+		if err != nil {
+			t.Errorf("ReadNumberWithUnit(%v, %s) returned error: %v", test.input, test.unit, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ReadNumberWithUnit(%v, %s) = %s, expected %s", test.input, test.unit, result, test.expected)
+		}
+	}
+
+	if _, err := ReadNumberWithUnit("abc", "เมตร"); err == nil {
+		t.Errorf("ReadNumberWithUnit(abc) expected error, got nil")
+	}
+}
+
+func TestConvertCount(t *testing.T) {
+	tests := []struct {
+		input      any
+		classifier string
+		expected   string
+	}{
+		{23, "ชิ้น", "ยี่สิบสามชิ้น"},
+		{"1,000", "คน", "หนึ่งพันคน"},
+		{uint8(1), "ตัว", "หนึ่งตัว"},
+		{"11.00", "เล่ม", "สิบเอ็ดเล่ม"},
+		{0, "กล่อง", "ศูนย์กล่อง"},
+		{"-0", "กล่อง", "ศูนย์กล่อง"},
+		{"007", "ขวด", "เจ็ดขวด"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertCount(test.input, test.classifier)
+		if err != nil {
+			t.Errorf("ConvertCount(%v, %s) returned error: %v", test.input, test.classifier, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertCount(%v, %s) = %s, expected %s", test.input, test.classifier, result, test.expected)
+		}
+	}
+
+	for _, input := range []any{-3, "2.5", 1.25, "abc"} {
+		if _, err := ConvertCount(input, "ชิ้น"); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("ConvertCount(%v) error = %v, expected invalid input", input, err)
+		}
+	}
+}
+
+func TestConvertInteger(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"1234.99", "หนึ่งพันสองร้อยสามสิบสี่"},
+		{1000000, "หนึ่งล้าน"},
+		{"21", "ยี่สิบเอ็ด"},
+		{"0.75", "ศูนย์"},
+		{"-0.75", "ศูนย์"},
+		{"-21.5", "ลบยี่สิบเอ็ด"},
+		{"99.999", "เก้าสิบเก้า"}, // truncated, never carried into the integer
+	}
+
+	for _, test := range tests {
+		result, err := ConvertInteger(test.input)
+		if err != nil {
+			t.Errorf("ConvertInteger(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertInteger(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := ConvertInteger("abc"); err == nil {
+		t.Errorf("ConvertInteger(abc) expected error, got nil")
+	}
+}
+
+func TestConvertSatang(t *testing.T) {
+	tests := []struct {
+		input    int
+		expected string
+	}{
+		{0, "ศูนย์"},
+		{1, "หนึ่ง"},
+		{11, "สิบเอ็ด"},
+		{21, "ยี่สิบเอ็ด"},
+		{45, "สี่สิบห้า"},
+		{99, "เก้าสิบเก้า"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertSatang(test.input)
+		if err != nil {
+			t.Errorf("ConvertSatang(%d) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertSatang(%d) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	for _, input := range []int{-1, 100} {
+		if _, err := ConvertSatang(input); err == nil {
+			t.Errorf("ConvertSatang(%d) expected error, got nil", input)
+		}
+	}
+}
+
+func TestConvertNumber(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"123.45", "หนึ่งร้อยยี่สิบสามจุดสี่ห้า"},
+		{"123", "หนึ่งร้อยยี่สิบสาม"},
+		{"0", "ศูนย์"},
+		{"0.001", "ศูนย์จุดศูนย์ศูนย์หนึ่ง"},
+		{"-11.5", "ลบสิบเอ็ดจุดห้า"},
+		{1000000, "หนึ่งล้าน"},
+		{"3.14", "สามจุดหนึ่งสี่"},
+		{"3.10", "สามจุดหนึ่งศูนย์"},
+		{"3.05", "สามจุดศูนย์ห้า"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertNumber(test.input)
+		if err != nil {
+			t.Errorf("ConvertNumber(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertNumber(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := ConvertNumber("abc"); err == nil {
+		t.Errorf("ConvertNumber(abc) expected error, got nil")
+	}
+}
+
+func TestConverterConvertNumberTrimFractionZeros(t *testing.T) {
+	converter := NewConverter(&Config{TrimFractionZeros: true})
+
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"3.10", "สามจุดหนึ่ง"},
+		{"3.05", "สามจุดศูนย์ห้า"},
+		{"3.00", "สาม"},
+		{"0.500", "ศูนย์จุดห้า"},
+		{"-2.0", "ลบสอง"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.ConvertNumber(test.input)
+		if err != nil {
+			t.Errorf("ConvertNumber(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertNumber(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestConvertPercent(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"12.5%", "สิบสองจุดห้าเปอร์เซ็นต์"},
+		{"7%", "เจ็ดเปอร์เซ็นต์"},
+		{100, "หนึ่งร้อยเปอร์เซ็นต์"},
+		{"0.25", "ศูนย์จุดสองห้าเปอร์เซ็นต์"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertPercent(test.input)
+		if err != nil {
+			t.Errorf("ConvertPercent(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertPercent(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := ConvertPercent("5%%"); err == nil {
+		t.Errorf("ConvertPercent(5%%%%) expected error, got nil")
+	}
+}
+
+func TestConverterNumberWords(t *testing.T) {
+	digits := [10]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+	converter := NewConverter(&Config{DigitWords: digits, NegativePrefix: "minus "})
+
+	tests := []struct {
+		read     func() (string, error)
+		name     string
+		expected string
+	}{
+		{func() (string, error) { return converter.ConvertNumber("3.05") }, "ConvertNumber(3.05)", "threeจุดzerofive"},
+		{func() (string, error) { return converter.ConvertNumber("-0.5") }, "ConvertNumber(-0.5)", "minus zeroจุดfive"},
+		{func() (string, error) { return converter.ConvertPercent("7%") }, "ConvertPercent(7%)", "sevenเปอร์เซ็นต์"},
+		{func() (string, error) { return converter.ReadNumberWithUnit("2.5", "กิโลกรัม") }, "ReadNumberWithUnit(2.5)", "twoจุดfiveกิโลกรัม"},
+	}
+
+	for _, test := range tests {
+		result, err := test.read()
+		if err != nil {
+			t.Errorf("%s returned error: %v", test.name, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("%s = %s, expected %s", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestConverterNumberSeparators(t *testing.T) {
+	converter := NewConverter(&Config{DecimalSeparator: ',', ThousandSeparator: '.'})
+
+	tests := []struct {
+		read     func() (string, error)
+		name     string
+		expected string
+	}{
+		{func() (string, error) { return converter.ConvertNumber("1.234,5") }, "ConvertNumber(1.234,5)", "หนึ่งพันสองร้อยสามสิบสี่จุดห้า"},
+		{func() (string, error) { return converter.ConvertPercent("12,5%") }, "ConvertPercent(12,5%)", "สิบสองจุดห้าเปอร์เซ็นต์"},
+		{func() (string, error) { return converter.ReadNumberWithUnit("2,5", "กิโลกรัม") }, "ReadNumberWithUnit(2,5)", "สองจุดห้ากิโลกรัม"},
+	}
+
+	for _, test := range tests {
+		result, err := test.read()
+		if err != nil {
+			t.Errorf("%s returned error: %v", test.name, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("%s = %s, expected %s", test.name, result, test.expected)
+		}
+	}
+
+	if _, err := converter.ConvertNumber("1,234.5"); err == nil {
+		t.Error("ConvertNumber(1,234.5) with European separators expected an error")
+	}
+}
+
+func TestConverterNumberRejectCommas(t *testing.T) {
+	converter := NewConverter(&Config{RejectCommas: true})
+
+	for _, input := range []string{"12,34", "1,234.5"} {
+		if _, err := converter.ConvertNumber(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("ConvertNumber(%s) with RejectCommas error = %v, expected invalid input", input, err)
+		}
+	}
+	if _, err := converter.ConvertPercent("1,5%"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("ConvertPercent(1,5%%) with RejectCommas error = %v, expected invalid input", err)
+	}
+	if result, err := converter.ConvertNumber("1234.5"); err != nil || result != "หนึ่งพันสองร้อยสามสิบสี่จุดห้า" {
+		t.Errorf("ConvertNumber(1234.5) with RejectCommas = %s, %v, expected หนึ่งพันสองร้อยสามสิบสี่จุดห้า", result, err)
+	}
+}
+
+func TestConverterNumberStrictParsing(t *testing.T) {
+	converter := NewConverter(&Config{StrictParsing: true})
+
+	for _, input := range []string{"1_2", " 12", "+5", "1,23"} {
+		if _, err := converter.ConvertNumber(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("ConvertNumber(%q) with StrictParsing error = %v, expected invalid input", input, err)
+		}
+	}
+	if result, err := converter.ConvertNumber("1,234.5"); err != nil || result != "หนึ่งพันสองร้อยสามสิบสี่จุดห้า" {
+		t.Errorf("ConvertNumber(1,234.5) with StrictParsing = %s, %v, expected หนึ่งพันสองร้อยสามสิบสี่จุดห้า", result, err)
+	}
+	if result, err := converter.ConvertPercent("12.5%"); err != nil || result != "สิบสองจุดห้าเปอร์เซ็นต์" {
+		t.Errorf("ConvertPercent(12.5%%) with StrictParsing = %s, %v, expected สิบสองจุดห้าเปอร์เซ็นต์", result, err)
+	}
+}
@@ -0,0 +1,181 @@
+package thbtextizer
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// parseDigitValues and parseUnitValues map reading words back to their
+// values, built from digitNames and unitNames
+var (
+	parseDigitValues = map[string]int64{"ศูนย์": 0, "เอ็ด": 1, "ยี่": 2}
+	parseUnitValues  = map[string]int64{}
+)
+
+func init() {
+	for digit, name := range digitNames[1:] {
+		parseDigitValues[name] = int64(digit + 1)
+	}
+	for index, name := range unitNames {
+		if index >= 1 && index <= 5 {
+			parseUnitValues[name] = pow10(index)
+		}
+	}
+}
+
+func pow10(n int) int64 {
+	value := int64(1)
+	for range n {
+		value *= 10
+	}
+	return value
+}
+
+// Parse reads a baht reading produced by Convert back into a decimal string
+// with two satang digits, for validating scanned cheques:
+//
+//	Parse("หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์") -> "123.45"
+//	Parse("หนึ่งร้อยบาทถ้วน")                 -> "100.00"
+//
+// Whitespace is ignored, and whole amounts without "ถ้วน" as written by
+// ConvertForPayment are accepted. Unrecognized words and readings that are not in
+// Convert's form return an ErrorCodeParseError error.
+func Parse(text string) (string, error) {
+	return parseThaiAmount(text)
+}
+
+// parseThaiAmount reads a baht reading such as "หนึ่งร้อยบาทห้าสิบสตางค์"
+// back into a decimal string such as "100.50"
+func parseThaiAmount(text string) (string, error) {
+	input := text
+	text = strings.Join(strings.Fields(text), "")
+
+	negative := strings.HasPrefix(text, "ลบ")
+	text = strings.TrimPrefix(text, "ลบ")
+
+	bahtWords, satangWords, found := strings.Cut(text, THB.Unit)
+	if !found {
+		return "", newParseError(input, "missing "+THB.Unit)
+	}
+	if bahtWords == "" {
+		return "", newParseError(input, "missing the baht amount")
+	}
+
+	baht, err := parseThaiNumber(input, bahtWords)
+	if err != nil {
+		return "", err
+	}
+
+	satang := new(big.Int)
+	switch {
+	case satangWords == "" || satangWords == THB.Even:
+	case strings.HasSuffix(satangWords, THB.SubUnit) && satangWords != THB.SubUnit:
+		satang, err = parseThaiNumber(input, strings.TrimSuffix(satangWords, THB.SubUnit))
+		if err != nil {
+			return "", err
+		}
+		if satang.Cmp(big.NewInt(99)) > 0 {
+			return "", newParseError(input, "satang must be below one hundred")
+		}
+	default:
+		return "", newParseError(input, "reading must end in "+THB.Even+" or "+THB.SubUnit)
+	}
+
+	var builder strings.Builder
+	if negative && (baht.Sign() != 0 || satang.Sign() != 0) {
+		builder.WriteByte('-')
+	}
+	builder.WriteString(baht.String())
+	builder.WriteByte('.')
+	if satang.Cmp(big.NewInt(10)) < 0 {
+		builder.WriteByte('0')
+	}
+	builder.WriteString(satang.String())
+
+	return builder.String(), nil
+}
+
+// parseThaiNumber reads spelled Thai digits and units, including stacked
+// "ล้าน" groups, back into an integer. Within a group, units must descend
+// and every digit word must be followed by a unit except in the ones place.
+// The tens follow Convert, so "หนึ่งสิบ" and "สองสิบ" are rejected.
+func parseThaiNumber(input, words string) (*big.Int, error) {
+	million := big.NewInt(1000000)
+	total := new(big.Int)
+
+	var (
+		group     int64      // value below the current ล้าน boundary
+		lastUnit  = pow10(6) // the previous unit in this group
+		pending   string     // digit word waiting for its unit
+		seenWords bool       // anything has been read so far
+	)
+
+	for _, token := range tokenize(words, vocabulary(convertOptions{})) {
+		if _, ok := parseDigitValues[token]; ok {
+			if pending != "" {
+				return nil, newParseError(input, "two digits in a row: "+pending+token)
+			}
+			if token == "เอ็ด" && !seenWords {
+				return nil, newParseError(input, "เอ็ด cannot start a number")
+			}
+			pending = token
+			seenWords = true
+			continue
+		}
+
+		if unit, ok := parseUnitValues[token]; ok {
+			if unit >= lastUnit {
+				return nil, newParseError(input, "unit "+token+" out of order")
+			}
+			digit := int64(1) // "สิบ" and elided leading ones
+			switch pending {
+			case "":
+			case "เอ็ด":
+				return nil, newParseError(input, "เอ็ด must be in the ones place")
+			case "ยี่":
+				if unit != 10 {
+					return nil, newParseError(input, "ยี่ must be followed by สิบ")
+				}
+				digit = 2
+			default:
+				digit = parseDigitValues[pending]
+				// Convert reads 10 and 20 as "สิบ" and "ยี่สิบ"
+				if unit == 10 && (digit == 1 || digit == 2) {
+					return nil, newParseError(input, pending+"สิบ is not a reading of "+strconv.FormatInt(digit*10, 10))
+				}
+			}
+			group += digit * unit
+			lastUnit = unit
+			pending = ""
+			seenWords = true
+			continue
+		}
+
+		if token == unitNames[6] {
+			if !seenWords {
+				return nil, newParseError(input, "ล้าน cannot start a number")
+			}
+			if pending == "ยี่" {
+				return nil, newParseError(input, "ยี่ must be followed by สิบ")
+			}
+			if pending != "" {
+				group += parseDigitValues[pending]
+			}
+			total.Add(total, big.NewInt(group))
+			total.Mul(total, million)
+			group, lastUnit, pending = 0, pow10(6), ""
+			continue
+		}
+
+		return nil, newParseError(input, "unrecognized word "+token)
+	}
+
+	if pending == "ยี่" {
+		return nil, newParseError(input, "ยี่ must be followed by สิบ")
+	}
+	if pending != "" {
+		group += parseDigitValues[pending]
+	}
+	return total.Add(total, big.NewInt(group)), nil
+}
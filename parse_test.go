@@ -0,0 +1,87 @@
+package thbtextizer
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์", "123.45"},
+		{"หนึ่งร้อยบาทถ้วน", "100.00"},
+		{"หนึ่งร้อยบาท", "100.00"},
+		{"ศูนย์บาทหนึ่งสตางค์", "0.01"},
+		{"ยี่สิบเอ็ดบาทสิบเอ็ดสตางค์", "21.11"},
+		{"ลบห้าบาทถ้วน", "-5.00"},
+		{"หนึ่งล้านล้านเอ็ดบาทถ้วน", "1000000000001.00"},
+		{"สิบเอ็ดล้านสิบเอ็ดล้านบาทถ้วน", "11000011000000.00"},
+		{"หนึ่งร้อย ล้าน บาท ถ้วน", "100000000.00"},
+		{"แสนบาทถ้วน", "100000.00"},
+	}
+
+	for _, test := range tests {
+		result, err := Parse(test.input)
+		if err != nil {
+			t.Errorf("Parse(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Parse(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, test := range convertTestCases {
+		normalized, err := normalizeAmount(test.input, RoundHalf, convertOptions{})
+		if err != nil {
+			t.Errorf("normalizeAmount(%s) returned error: %v", test.input, err)
+			continue
+		}
+
+		text, _ := Convert(test.input)
+		result, err := Parse(text)
+		if err != nil {
+			t.Errorf("Parse(Convert(%s)) returned error: %v", test.input, err)
+			continue
+		}
+		if expected := normalized.decimalString(); result != expected {
+			t.Errorf("Parse(Convert(%s)) = %s, expected %s", test.input, result, expected)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	inputs := []string{
+		"",
+		"หนึ่งร้อย", // no บาท
+		"บาทถ้วน",   // no amount
+		"หนึ่งร้อยบาทห้าสิบ",          // no สตางค์
+		"หนึ่งร้อยบาทสตางค์",          // empty satang
+		"หนึ่งร้อยบาทหนึ่งร้อยสตางค์", // satang too large
+		"สองสามบาทถ้วน",               // two digits
+		"ร้อยพันบาทถ้วน",              // units ascending
+		"ยี่ร้อยบาทถ้วน",              // ยี่ before ร้อย
+		"เอ็ดบาทถ้วน",                 // leading เอ็ด
+		"ล้านบาทถ้วน",                 // leading ล้าน
+		"หนึ่งร้อยดอลลาร์ถ้วน",        // unknown word
+		"สองสิบบาทถ้วน",               // ยี่สิบ spelled สองสิบ
+		"หนึ่งสิบบาทถ้วน",             // สิบ spelled หนึ่งสิบ
+		"หนึ่งร้อยหนึ่งสิบบาทถ้วน",    // หนึ่งสิบ after higher digits
+		"ศูนย์บาทสองสิบสตางค์",        // non-canonical satang tens
+		"สองสิบล้านบาทถ้วน",           // non-canonical tens before ล้าน
+	}
+
+	for _, input := range inputs {
+		_, err := Parse(input)
+		if err == nil {
+			t.Errorf("Parse(%s) expected error, got nil", input)
+			continue
+		}
+		if convErr, ok := err.(*ConversionError); !ok || convErr.Code != ErrorCodeParseError {
+			t.Errorf("Parse(%s) returned %v, expected ErrorCodeParseError", input, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package thbtextizer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ConvertForPayment converts amount to the compact reading used in payment
+// memos such as PromptPay transfer descriptions. Whole amounts end at "บาท"
+// without "ถ้วน", and satang is only read when non-zero:
+//
+//	100    -> "หนึ่งร้อยบาท"
+//	100.50 -> "หนึ่งร้อยบาทห้าสิบสตางค์"
+func ConvertForPayment(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	opts := globalOptions()
+	opts.omitEvenSuffix = true
+
+	return convertWithMode(amount, mode, opts)
+}
+
+// ConvertForPayment converts amount to a payment memo reading using instance configuration
+func (c *Converter) ConvertForPayment(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	opts := c.config.options()
+	opts.omitEvenSuffix = true
+
+	return c.convertWithOptions(amount, roundingMode, opts)
+}
+
+// ConvertChange reads the change due when paid is handed over for price,
+// framed for cashier screens as "ทอนเงิน ...". Both amounts are rounded to
+// satang first and subtracted exactly. Negative amounts and paying less than
+// the price are ErrorCodeInvalidInput errors.
+func ConvertChange(paid, price any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertChange(paid, price, mode, globalOptions())
+}
+
+// ConvertChange reads the change due when paid is handed over for price
+// using instance configuration
+func (c *Converter) ConvertChange(paid, price any, roundingMode ...DecimalRoundingMode) (string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertChange(paid, price, mode, c.config.options())
+}
+
+func convertChange(paid, price any, mode DecimalRoundingMode, opts convertOptions) (string, error) {
+	paidUnits, err := amountToSatang(paid, mode, opts)
+	if err != nil {
+		return "", localizeError(err, opts.errorLanguage)
+	}
+	if paidUnits.Sign() < 0 {
+		return "", localizeError(newInvalidInputError(fmt.Sprintf("%v", paid), reasonNegativePaid), opts.errorLanguage)
+	}
+	priceUnits, err := amountToSatang(price, mode, opts)
+	if err != nil {
+		return "", localizeError(err, opts.errorLanguage)
+	}
+	if priceUnits.Sign() < 0 {
+		return "", localizeError(newInvalidInputError(fmt.Sprintf("%v", price), reasonNegativePrice), opts.errorLanguage)
+	}
+
+	change := new(big.Int).Sub(paidUnits, priceUnits)
+	if change.Sign() < 0 {
+		return "", localizeError(newInsufficientPaymentError(fmt.Sprintf("%v", paid)), opts.errorLanguage)
+	}
+
+	places := opts.minorDigits()
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	baht, satang := new(big.Int).QuoRem(change, scale, new(big.Int))
+	text := renderAmount(normalizedAmount{
+		integer: baht.String(),
+		decimal: fmt.Sprintf("%0*d", places, satang.Int64()),
+	}, opts)
+
+	return "ทอนเงิน " + text, nil
+}
+
+// amountToSatang normalizes amount with opts and returns it as a whole
+// number of minor units
+func amountToSatang(amount any, mode DecimalRoundingMode, opts convertOptions) (*big.Int, error) {
+	normalized, err := normalizeAmount(amount, mode, opts)
+	if err != nil {
+		return nil, err
+	}
+	decimalPart := normalized.decimal
+	if decimalPart == "" {
+		decimalPart = strings.Repeat("0", opts.minorDigits())
+	}
+
+	satang, ok := new(big.Int).SetString(normalized.integer+decimalPart, 10)
+	if !ok {
+		return nil, newInvalidInputError(normalized.input, reasonCannotParse)
+	}
+	if normalized.negative {
+		satang.Neg(satang)
+	}
+	return satang, nil
+}
@@ -0,0 +1,106 @@
+package thbtextizer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestConvertForPayment(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"100", "หนึ่งร้อยบาท"},
+		{"100.00", "หนึ่งร้อยบาท"},
+		{"100.50", "หนึ่งร้อยบาทห้าสิบสตางค์"},
+		{1500, "หนึ่งพันห้าร้อยบาท"},
+		{"0.25", "ศูนย์บาทยี่สิบห้าสตางค์"},
+		{"2,499.99", "สองพันสี่ร้อยเก้าสิบเก้าบาทเก้าสิบเก้าสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertForPayment(test.input)
+		if err != nil {
+			t.Errorf("ConvertForPayment(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertForPayment(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	converter := NewConverter(&Config{AllowOverflow: true})
+	result, err := converter.ConvertForPayment("99.995")
+	if err != nil {
+		t.Errorf("Converter.ConvertForPayment returned error: %v", err)
+	}
+	if expected := "หนึ่งร้อยบาท"; result != expected {
+		t.Errorf("Converter.ConvertForPayment = %s, expected %s", result, expected)
+	}
+}
+
+func TestConvertChange(t *testing.T) {
+	tests := []struct {
+		paid     any
+		price    any
+		expected string
+	}{
+		{"100", "100", "ทอนเงิน ศูนย์บาทถ้วน"},
+		{1000, "350.75", "ทอนเงิน หกร้อยสี่สิบเก้าบาทยี่สิบห้าสตางค์"},
+		{"500.50", "500", "ทอนเงิน ศูนย์บาทห้าสิบสตางค์"},
+		{"1,000,000", 1, "ทอนเงิน เก้าแสนเก้าหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าบาทถ้วน"},
+		{"20", "0.105", "ทอนเงิน สิบเก้าบาทแปดสิบเก้าสตางค์"}, // price rounds to 0.11
+	}
+
+	for _, test := range tests {
+		result, err := ConvertChange(test.paid, test.price)
+		if err != nil {
+			t.Errorf("ConvertChange(%v, %v) returned error: %v", test.paid, test.price, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertChange(%v, %v) = %s, expected %s", test.paid, test.price, result, test.expected)
+		}
+	}
+
+	_, err := ConvertChange("99.99", "100")
+	if convErr, ok := err.(*ConversionError); !ok || convErr.Code != ErrorCodeInvalidInput {
+		t.Errorf("ConvertChange with insufficient payment expected ErrorCodeInvalidInput, got %v", err)
+	}
+
+	if convErr, ok := err.(*ConversionError); ok && strings.Contains(convErr.Hint, "numeric characters") {
+		t.Errorf("ConvertChange with insufficient payment hint = %q, expected a payment hint", convErr.Hint)
+	}
+
+	if _, err := ConvertChange("abc", "100"); err == nil {
+		t.Errorf("ConvertChange with invalid paid amount expected error, got nil")
+	}
+
+	for _, amounts := range [][2]string{{"100", "-20"}, {"-5", "-20"}, {"-100", "20"}} {
+		if _, err := ConvertChange(amounts[0], amounts[1]); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("ConvertChange(%s, %s) error = %v, expected invalid input", amounts[0], amounts[1], err)
+		}
+	}
+}
+
+func TestConverterConvertChange(t *testing.T) {
+	converter := NewConverter(&Config{MajorUnit: "ดอลลาร์", MinorUnit: "เซนต์", MaxValue: "1000", ErrorLanguage: ErrorLanguageThai})
+
+	result, err := converter.ConvertChange("100", "20.50")
+	if err != nil {
+		t.Fatalf("ConvertChange(100, 20.50) returned error: %v", err)
+	}
+	if expected := "ทอนเงิน เจ็ดสิบเก้าดอลลาร์ห้าสิบเซนต์"; result != expected {
+		t.Errorf("ConvertChange(100, 20.50) = %s, expected %s", result, expected)
+	}
+
+	if _, err := converter.ConvertChange("5000", "20"); !errors.Is(err, ErrExceedsMaxValue) {
+		t.Errorf("ConvertChange(5000, 20) over MaxValue error = %v, expected ErrExceedsMaxValue", err)
+	}
+
+	_, err = converter.ConvertChange("10", "20")
+	if err == nil || !strings.Contains(err.Error(), "จ่ายไม่พอ") || !strings.Contains(err.Error(), "จ่ายเงินให้ไม่น้อยกว่าราคา") {
+		t.Errorf("ConvertChange(10, 20) error = %v, expected the Thai insufficient payment message and hint", err)
+	}
+}
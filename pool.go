@@ -0,0 +1,28 @@
+package thbtextizer
+
+import (
+	"bytes"
+	"sync"
+)
+
+// bufferPool recycles the buffers readings are assembled in, so repeated
+// conversions do not allocate and grow a new buffer each time
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// maxPooledBuffer keeps a rare oversized reading from pinning a large
+// buffer in the pool
+const maxPooledBuffer = 4 << 10
+
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBuffer {
+		bufferPool.Put(buf)
+	}
+}
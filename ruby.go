@@ -0,0 +1,60 @@
+package thbtextizer
+
+import (
+	"html/template"
+	"strings"
+)
+
+// romanization maps reading words to their RTGS romanization
+var romanization = map[string]string{
+	"หนึ่ง": "nueng", "สอง": "song", "สาม": "sam", "สี่": "si", "ห้า": "ha",
+	"หก": "hok", "เจ็ด": "chet", "แปด": "paet", "เก้า": "kao", "ศูนย์": "sun",
+	"เอ็ด": "et", "ยี่": "yi", "สิบ": "sip", "ร้อย": "roi", "พัน": "phan",
+	"หมื่น": "muen", "แสน": "saen", "ล้าน": "lan", "ลบ": "lop", "จุด": "chut",
+	"บาท": "baht", "สตางค์": "satang", "ถ้วน": "thuan",
+}
+
+// ConvertRuby converts amount to an HTML fragment that annotates each word
+// with its romanization for language learners:
+//
+//	ConvertRuby(21) -> <ruby>ยี่<rt>yi</rt></ruby><ruby>สิบ<rt>sip</rt></ruby>...
+//
+// Words without a romanization, such as a custom Suffix, are written as
+// escaped plain text.
+func ConvertRuby(amount any, roundingMode ...DecimalRoundingMode) (template.HTML, error) {
+	tokens, err := ConvertTokens(amount, roundingMode...)
+	if err != nil {
+		return "", err
+	}
+	return renderRuby(tokens), nil
+}
+
+// ConvertRuby converts amount to ruby-annotated HTML using instance configuration
+func (c *Converter) ConvertRuby(amount any, roundingMode ...DecimalRoundingMode) (template.HTML, error) {
+	tokens, err := c.ConvertTokens(amount, roundingMode...)
+	if err != nil {
+		return "", err
+	}
+	return renderRuby(tokens), nil
+}
+
+// renderRuby wraps each token in ruby markup, escaping all text
+func renderRuby(tokens []string) template.HTML {
+	var builder strings.Builder
+	builder.Grow(len(tokens) * 32)
+
+	for _, token := range tokens {
+		roman, ok := romanization[token]
+		if !ok {
+			builder.WriteString(template.HTMLEscapeString(token))
+			continue
+		}
+		builder.WriteString("<ruby>")
+		builder.WriteString(template.HTMLEscapeString(token))
+		builder.WriteString("<rt>")
+		builder.WriteString(template.HTMLEscapeString(roman))
+		builder.WriteString("</rt></ruby>")
+	}
+
+	return template.HTML(builder.String())
+}
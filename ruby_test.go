@@ -0,0 +1,34 @@
+package thbtextizer
+
+import (
+	"testing"
+)
+
+func TestConvertRuby(t *testing.T) {
+	result, err := ConvertRuby("21.5")
+	if err != nil {
+		t.Fatalf("ConvertRuby(21.5) returned error: %v", err)
+	}
+
+	expected := "<ruby>ยี่<rt>yi</rt></ruby><ruby>สิบ<rt>sip</rt></ruby><ruby>เอ็ด<rt>et</rt></ruby>" +
+		"<ruby>บาท<rt>baht</rt></ruby><ruby>ห้า<rt>ha</rt></ruby><ruby>สิบ<rt>sip</rt></ruby>" +
+		"<ruby>สตางค์<rt>satang</rt></ruby>"
+	if string(result) != expected {
+		t.Errorf("ConvertRuby(21.5) = %s, expected %s", result, expected)
+	}
+
+	// Unknown words are escaped rather than trusted as markup
+	converter := NewConverter(&Config{Suffix: " <b>&</b>"})
+	result, err = converter.ConvertRuby("1")
+	if err != nil {
+		t.Fatalf("ConvertRuby(1) returned error: %v", err)
+	}
+	expected = "<ruby>หนึ่ง<rt>nueng</rt></ruby><ruby>บาท<rt>baht</rt></ruby><ruby>ถ้วน<rt>thuan</rt></ruby>&lt;b&gt;&amp;&lt;/b&gt;"
+	if string(result) != expected {
+		t.Errorf("ConvertRuby(1) with markup suffix = %s, expected %s", result, expected)
+	}
+
+	if _, err := ConvertRuby("abc"); err == nil {
+		t.Errorf("ConvertRuby(abc) expected error, got nil")
+	}
+}
@@ -0,0 +1,68 @@
+package thbtextizer
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// StreamErrorPrefix starts the line ConvertStream writes in place of a
+// reading when an input line cannot be converted
+const StreamErrorPrefix = "ERROR: "
+
+// ConvertStream reads one amount per line from r and writes one reading per
+// line to w, so the package can sit at the core of a shell pipeline. Lines
+// may end in LF or CRLF and blank lines are skipped. A line that cannot be
+// converted produces StreamErrorPrefix followed by the error message instead
+// of a reading, and the stream carries on; only read and write errors stop
+// it. Output is buffered and the readings are written straight into the
+// buffer, so no string is built per line.
+//
+//	"123.45\r\n\r\nabc\n" -> "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์\nERROR: ...\n"
+func ConvertStream(r io.Reader, w io.Writer, roundingMode ...DecimalRoundingMode) error {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertStream(r, w, mode, globalOptions())
+}
+
+// ConvertStream converts one amount per line using instance configuration
+func (c *Converter) ConvertStream(r io.Reader, w io.Writer, roundingMode ...DecimalRoundingMode) error {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertStream(r, w, mode, c.config.options())
+}
+
+func convertStream(r io.Reader, w io.Writer, mode DecimalRoundingMode, opts convertOptions) error {
+	scanner := bufio.NewScanner(r)
+	out := bufio.NewWriter(w)
+
+	for scanner.Scan() {
+		// TrimSpace also drops the '\r' of CRLF line endings
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		normalized, err := prepareAmount(line, mode, opts)
+		if err != nil {
+			out.WriteString(StreamErrorPrefix)
+			out.WriteString(err.Error())
+		} else if err := writeReading(out, readAmount(normalized, opts), opts.units(), opts); err != nil {
+			return err
+		}
+		if err := out.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return out.Flush()
+}
@@ -0,0 +1,56 @@
+package thbtextizer
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestConvertStream(t *testing.T) {
+	input := "123.45\r\n\r\nabc\n  100  \n\n-0.5"
+
+	var buf bytes.Buffer
+	if err := ConvertStream(strings.NewReader(input), &buf); err != nil {
+		t.Fatalf("ConvertStream returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("ConvertStream wrote %d lines, expected 4: %q", len(lines), buf.String())
+	}
+
+	for i, amount := range []string{"123.45", "", "100", "-0.5"} {
+		if amount == "" {
+			continue
+		}
+		expected, _ := Convert(amount)
+		if lines[i] != expected {
+			t.Errorf("ConvertStream line %d = %s, expected %s", i, lines[i], expected)
+		}
+	}
+
+	_, convertErr := Convert("abc")
+	if expected := StreamErrorPrefix + convertErr.Error(); lines[1] != expected {
+		t.Errorf("ConvertStream line 1 = %s, expected %s", lines[1], expected)
+	}
+}
+
+func TestConverterConvertStream(t *testing.T) {
+	converter := NewConverter(&Config{DefaultRounding: RoundDown})
+
+	var buf bytes.Buffer
+	if err := converter.ConvertStream(strings.NewReader("1.999\n2\n"), &buf); err != nil {
+		t.Fatalf("ConvertStream returned error: %v", err)
+	}
+	if expected := "หนึ่งบาทเก้าสิบเก้าสตางค์\nสองบาทถ้วน\n"; buf.String() != expected {
+		t.Errorf("ConvertStream wrote %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestConvertStreamWriteError(t *testing.T) {
+	writeErr := errors.New("disk full")
+	if err := ConvertStream(strings.NewReader("1\n2\n"), failingWriter{err: writeErr}); !errors.Is(err, writeErr) {
+		t.Errorf("ConvertStream with failing writer returned %v, expected %v", err, writeErr)
+	}
+}
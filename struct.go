@@ -0,0 +1,79 @@
+package thbtextizer
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ConvertStruct reads every struct field tagged with `thbtext` and returns
+// the readings keyed by field name. The first tag element overrides the key
+// and "-" skips the field; a round option picks the field's rounding mode
+// using ParseRoundingMode names:
+//
+//	type Invoice struct {
+//		Total    string  `thbtext:""`
+//		Discount float64 `thbtext:"discount,round=down"`
+//	}
+//
+// v must be a struct or a non-nil pointer to one.
+func ConvertStruct(v any) (map[string]string, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, newInvalidInputError(fmt.Sprintf("%T", v), reasonNotStruct)
+	}
+
+	results := make(map[string]string)
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		tag, ok := field.Tag.Lookup("thbtext")
+		if !ok || tag == "-" {
+			continue
+		}
+		if !field.IsExported() {
+			return nil, newInvalidInputError(field.Name, reasonUnexportedTag)
+		}
+
+		key, mode, err := parseStructTag(field.Name, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		text, err := Convert(value.Field(i).Interface(), mode)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		results[key] = text
+	}
+
+	return results, nil
+}
+
+// parseStructTag splits a thbtext tag into the result key and rounding mode
+func parseStructTag(fieldName, tag string) (string, DecimalRoundingMode, error) {
+	parts := strings.Split(tag, ",")
+
+	key := parts[0]
+	if key == "" {
+		key = fieldName
+	}
+
+	mode := RoundHalf
+	for _, option := range parts[1:] {
+		name, arg, _ := strings.Cut(option, "=")
+		if name != "round" {
+			return "", mode, newInvalidInputError(tag, reasonUnknownTagOption, name, fieldName)
+		}
+		parsed, err := ParseRoundingMode(arg)
+		if err != nil {
+			return "", mode, err
+		}
+		mode = parsed
+	}
+
+	return key, mode, nil
+}
@@ -0,0 +1,97 @@
+package thbtextizer
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRoundingMode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected DecimalRoundingMode
+	}{
+		{"half", RoundHalf},
+		{"down", RoundDown},
+		{"UP", RoundUp},
+		{"RoundCeil", RoundCeil},
+		{" floor ", RoundFloor},
+		{"halfup", RoundHalfUp},
+		{"RoundHalfAwayFromZero", RoundHalf},
+	}
+
+	for _, test := range tests {
+		result, err := ParseRoundingMode(test.input)
+		if err != nil {
+			t.Errorf("ParseRoundingMode(%q) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ParseRoundingMode(%q) = %d, expected %d", test.input, result, test.expected)
+		}
+	}
+
+	for _, input := range []string{"", "nearest", "round"} {
+		if _, err := ParseRoundingMode(input); err == nil {
+			t.Errorf("ParseRoundingMode(%q) expected error, got nil", input)
+		}
+	}
+}
+
+func TestConvertStruct(t *testing.T) {
+	type invoice struct {
+		Total    string  `thbtext:"amount,round=half"`
+		Discount float64 `thbtext:",round=down"`
+		Note     string
+		Skipped  string `thbtext:"-"`
+	}
+
+	results, err := ConvertStruct(&invoice{Total: "100.005", Discount: 12.349, Note: "x", Skipped: "abc"})
+	if err != nil {
+		t.Fatalf("ConvertStruct returned error: %v", err)
+	}
+
+	expected := map[string]string{
+		"amount":   "หนึ่งร้อยบาทหนึ่งสตางค์",
+		"Discount": "สิบสองบาทสามสิบสี่สตางค์",
+	}
+	if len(results) != len(expected) {
+		t.Errorf("ConvertStruct returned %d readings, expected %d: %v", len(results), len(expected), results)
+	}
+	for key, want := range expected {
+		if results[key] != want {
+			t.Errorf("ConvertStruct[%s] = %s, expected %s", key, results[key], want)
+		}
+	}
+}
+
+func TestConvertStructErrors(t *testing.T) {
+	type badMode struct {
+		Total string `thbtext:",round=nearest"`
+	}
+	type badOption struct {
+		Total string `thbtext:",scale=2"`
+	}
+	type badValue struct {
+		Total string `thbtext:""`
+	}
+
+	inputs := []any{
+		badMode{Total: "1"},
+		badOption{Total: "1"},
+		badValue{Total: "abc"},
+		"not a struct",
+		(*badValue)(nil),
+	}
+	for _, input := range inputs {
+		if _, err := ConvertStruct(input); err == nil {
+			t.Errorf("ConvertStruct(%#v) expected error, got nil", input)
+		}
+	}
+
+	// Conversion errors keep their ConversionError details
+	_, err := ConvertStruct(badValue{Total: "abc"})
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Code != ErrorCodeInvalidInput {
+		t.Errorf("ConvertStruct(badValue) returned %v, expected a wrapped ErrorCodeInvalidInput", err)
+	}
+}
@@ -0,0 +1,47 @@
+// Package thbtest provides test helpers for code that renders amounts with
+// thbtextizer. It lives in its own package so the main package does not
+// import testing.
+package thbtest
+
+import (
+	"fmt"
+	"testing"
+
+	thbtextizer "github.com/natt-v/thai-baht-textizer"
+)
+
+// AssertText converts amount with thbtextizer.Convert and fails t if the
+// conversion errors or the text differs from expected. The failure message
+// shows both readings and the first rune where they diverge.
+func AssertText(t testing.TB, amount any, expected string, roundingMode ...thbtextizer.DecimalRoundingMode) {
+	t.Helper()
+
+	result, err := thbtextizer.Convert(amount, roundingMode...)
+	if err != nil {
+		t.Errorf("Convert(%v) returned error: %v", amount, err)
+		return
+	}
+	if result != expected {
+		t.Errorf("Convert(%v) mismatch\n%s", amount, diff(result, expected))
+	}
+}
+
+// diff describes where got and expected first differ, counted in runes
+func diff(got, expected string) string {
+	gotRunes, expectedRunes := []rune(got), []rune(expected)
+
+	position := 0
+	for position < len(gotRunes) && position < len(expectedRunes) && gotRunes[position] == expectedRunes[position] {
+		position++
+	}
+
+	return fmt.Sprintf("     got: %s\nexpected: %s\nfirst difference at rune %d: got %q, expected %q",
+		got, expected, position, remainder(gotRunes, position), remainder(expectedRunes, position))
+}
+
+func remainder(runes []rune, position int) string {
+	if position >= len(runes) {
+		return ""
+	}
+	return string(runes[position:])
+}
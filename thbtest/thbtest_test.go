@@ -0,0 +1,53 @@
+package thbtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	thbtextizer "github.com/natt-v/thai-baht-textizer"
+)
+
+// recorder captures failures instead of failing the enclosing test
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestAssertTextPass(t *testing.T) {
+	r := &recorder{TB: t}
+
+	AssertText(r, "123.45", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์")
+	AssertText(r, "123.456", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์", thbtextizer.RoundDown)
+
+	if len(r.failures) != 0 {
+		t.Errorf("AssertText reported failures for matching text: %v", r.failures)
+	}
+}
+
+func TestAssertTextFail(t *testing.T) {
+	r := &recorder{TB: t}
+
+	AssertText(r, "123.45", "หนึ่งร้อยยี่สิบสามบาทถ้วน")
+	if len(r.failures) != 1 {
+		t.Fatalf("AssertText reported %d failures for mismatched text, expected 1", len(r.failures))
+	}
+	message := r.failures[0]
+	for _, want := range []string{"got: หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์", "expected: หนึ่งร้อยยี่สิบสามบาทถ้วน", "first difference at rune 21"} {
+		if !strings.Contains(message, want) {
+			t.Errorf("AssertText failure message %q does not contain %q", message, want)
+		}
+	}
+
+	r = &recorder{TB: t}
+	AssertText(r, "abc", "")
+	if len(r.failures) != 1 || !strings.Contains(r.failures[0], "returned error") {
+		t.Errorf("AssertText with invalid input reported %v, expected a conversion error", r.failures)
+	}
+}
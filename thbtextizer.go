@@ -1,11 +1,19 @@
 package thbtextizer
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"log/slog"
+	"math/big"
+	"regexp"
 	"strconv"
 	"strings"
-	"unicode"
+	"unicode/utf8"
 )
 
 type ErrorCode int
@@ -15,13 +23,47 @@ const (
 	ErrorCodeExceedsMaxValue
 	ErrorCodeInvalidInput
 	ErrorCodeParseError
+	ErrorCodeRoundingOccurred
+	ErrorCodeCanceled
+	// ErrorCodeSatangCapped is a warning, never returned as an error:
+	// ConvertDetailed lists it when satang were capped at 99
+	ErrorCodeSatangCapped
 )
 
+// Sentinel errors matching each ErrorCode, so callers can write
+// errors.Is(err, ErrExceedsMaxValue) instead of comparing codes
+var (
+	ErrUnsupportedType  = errors.New("unsupported type")
+	ErrExceedsMaxValue  = errors.New("exceeds maximum value")
+	ErrInvalidInput     = errors.New("invalid input")
+	ErrParseError       = errors.New("parse error")
+	ErrRoundingOccurred = errors.New("rounding occurred")
+	ErrCanceled         = errors.New("conversion canceled")
+	ErrSatangCapped     = errors.New("satang capped")
+)
+
+// errorCodeSentinels maps each ErrorCode to its sentinel error
+var errorCodeSentinels = map[ErrorCode]error{
+	ErrorCodeUnsupportedType:  ErrUnsupportedType,
+	ErrorCodeExceedsMaxValue:  ErrExceedsMaxValue,
+	ErrorCodeInvalidInput:     ErrInvalidInput,
+	ErrorCodeParseError:       ErrParseError,
+	ErrorCodeRoundingOccurred: ErrRoundingOccurred,
+	ErrorCodeCanceled:         ErrCanceled,
+	ErrorCodeSatangCapped:     ErrSatangCapped,
+}
+
 type ConversionError struct {
 	Code    ErrorCode
 	Message string
 	Input   string
 	Hint    string
+	Err     error // underlying cause, such as context.Canceled
+
+	limit      string        // the maximum value an ErrorCodeExceedsMaxValue error broke
+	places     int           // the minor unit digits an ErrorCodeRoundingOccurred error kept
+	reason     invalidReason // why an ErrorCodeInvalidInput error rejected its input
+	reasonArgs []any         // the details the reason templates are filled with
 }
 
 func (e *ConversionError) Error() string {
@@ -31,10 +73,21 @@ func (e *ConversionError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying cause so errors.Is can match it
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the sentinel error for e.Code
+func (e *ConversionError) Is(target error) bool {
+	sentinel, ok := errorCodeSentinels[e.Code]
+	return ok && target == sentinel
+}
+
 func newUnsupportedTypeError(input string) *ConversionError {
 	return &ConversionError{
 		Code:    ErrorCodeUnsupportedType,
-		Message: "unsupported type: only string, int, uint, float32, float64 and their variants are supported",
+		Message: "unsupported type: only string, int, uint, float32, float64, *big.Float, json.Number and their variants are supported",
 		Input:   input,
 		Hint:    "convert your input to one of the supported types",
 	}
@@ -46,23 +99,81 @@ func newExceedsMaxValueError(input string, digits int) *ConversionError {
 		Message: fmt.Sprintf("input number exceeds maximum supported value of %s (got %d digits, max %d digits)", MaxSupportedValue, digits, len(MaxSupportedValue)),
 		Input:   input,
 		Hint:    "use a smaller number within the supported range",
+		limit:   MaxSupportedValue,
+	}
+}
+
+func newExceedsLimitError(input string, limit string) *ConversionError {
+	return &ConversionError{
+		Code:    ErrorCodeExceedsMaxValue,
+		Message: fmt.Sprintf("input number exceeds the configured maximum value of %s", limit),
+		Input:   input,
+		Hint:    "use a smaller number or raise Config.MaxValue",
+		limit:   limit,
 	}
 }
 
-func newInvalidInputError(input string, reason string) *ConversionError {
+func newInvalidInputError(input string, reason invalidReason, args ...any) *ConversionError {
 	return &ConversionError{
-		Code:    ErrorCodeInvalidInput,
-		Message: fmt.Sprintf("invalid input: %s", reason),
+		Code:       ErrorCodeInvalidInput,
+		Message:    fmt.Sprintf("invalid input: %s", fmt.Sprintf(reason.english, args...)),
+		Input:      input,
+		Hint:       "ensure input contains only valid numeric characters",
+		reason:     reason,
+		reasonArgs: args,
+	}
+}
+
+func newInsufficientPaymentError(input string) *ConversionError {
+	err := newInvalidInputError(input, reasonInsufficientPayment)
+	err.Hint = "pay at least the price"
+	return err
+}
+
+func newRoundingOccurredError(input string, places int) *ConversionError {
+	return &ConversionError{
+		Code:    ErrorCodeRoundingOccurred,
+		Message: fmt.Sprintf("rounding changed the value: %s has non-zero digits beyond %d decimal places", input, places),
 		Input:   input,
-		Hint:    "ensure input contains only valid numeric characters",
+		Hint:    "round the amount to satang before converting or disable ErrorOnRounding",
+		places:  places,
 	}
 }
 
-func sanitizeInput(input string) (string, error) {
+func newSatangCappedError(input string) *ConversionError {
+	return &ConversionError{
+		Code:    ErrorCodeSatangCapped,
+		Message: fmt.Sprintf("satang capped: %s rounds into the next baht, so its satang were capped at 99", input),
+		Input:   input,
+		Hint:    "review the amount or enable AllowOverflow",
+	}
+}
+
+func newCanceledError(input string, err error) *ConversionError {
+	return &ConversionError{
+		Code:    ErrorCodeCanceled,
+		Message: fmt.Sprintf("conversion canceled: %v", err),
+		Input:   input,
+		Err:     err,
+	}
+}
+
+func newParseError(input string, reason string) *ConversionError {
+	return &ConversionError{
+		Code:    ErrorCodeParseError,
+		Message: fmt.Sprintf("cannot parse Thai text: %s", reason),
+		Input:   input,
+		Hint:    "pass a reading in the form produced by Convert",
+	}
+}
+
+// sanitizeInput cleans up input and returns its unsigned digits along with
+// whether it carried a leading minus sign
+func sanitizeInput(input string) (string, bool, error) {
 	input = strings.TrimSpace(input)
 
 	if input == "" {
-		return "", newInvalidInputError(input, "empty input")
+		return "", false, newInvalidInputError(input, reasonEmptyInput)
 	}
 
 	// Remove common formatting characters (but preserve basic structure)
@@ -70,23 +181,48 @@ func sanitizeInput(input string) (string, error) {
 	input = strings.ReplaceAll(input, "_", "")  // Remove underscores
 	input = strings.ReplaceAll(input, "\t", "") // Remove tabs
 
+	// Thai digits such as ๑๒๓ from OCR'd documents read like 123
+	input = strings.Map(normalizeThaiDigit, input)
+
+	// Scientific notation such as 1.5e6 is expanded exactly, without going
+	// through a float
+	if strings.ContainsAny(input, "eE") {
+		expanded, err := expandExponent(input)
+		if err != nil {
+			return "", false, err
+		}
+		input = expanded
+	}
+
 	// Check for invalid characters (allow digits, decimal point, commas, and minus sign)
-	for i, r := range input {
-		if !unicode.IsDigit(r) && r != '.' && r != ',' && r != '-' && r != '+' {
-			return "", newInvalidInputError(input, fmt.Sprintf("invalid character '%c' at position %d", r, i))
+	// Positions count characters rather than bytes, so they match what a
+	// person counts in input with Thai or other multi-byte characters
+	position := 0
+	for _, r := range input {
+		if (r < '0' || r > '9') && r != '.' && r != ',' && r != '-' && r != '+' {
+			return "", false, newInvalidInputError(input, reasonInvalidCharacter, r, r, position)
 		}
+		position++
 	}
 
-	// Handle negative numbers (for future support)
-	if strings.HasPrefix(input, "-") || strings.HasPrefix(input, "+") {
-		// For now, just remove the sign (could be enhanced in future versions)
+	// Handle the sign, which is only allowed as the first character
+	negative := strings.HasPrefix(input, "-")
+	if negative || strings.HasPrefix(input, "+") {
 		input = input[1:]
 	}
+	if strings.ContainsAny(input, "+-") {
+		return "", false, newInvalidInputError(input, reasonSignPosition)
+	}
 
 	// Validate decimal point usage
 	dotCount := strings.Count(input, ".")
 	if dotCount > 1 {
-		return "", newInvalidInputError(input, "multiple decimal points")
+		return "", false, newInvalidInputError(input, reasonMultiplePoints)
+	}
+
+	// Commas only group integer digits, so one after the decimal point is a typo
+	if dot := strings.Index(input, "."); dot >= 0 && strings.Contains(input[dot:], ",") {
+		return "", false, newInvalidInputError(input, reasonCommaAfterPoint)
 	}
 
 	// Validate that we don't have decimal point at the start or end
@@ -97,7 +233,119 @@ func sanitizeInput(input string) (string, error) {
 		input = input + "0"
 	}
 
-	return input, nil
+	return input, negative, nil
+}
+
+// strictNumber matches the only string form accepted with StrictParsing: an
+// optional minus sign, digits optionally grouped by commas in threes, and
+// optional decimals
+var strictNumber = regexp.MustCompile(`^-?(\d+|\d{1,3}(,\d{3})+)(\.\d+)?$`)
+
+// applySeparators rewrites string input written with the separators
+// configured in opts into the standard "1,234.56" form. A standard separator
+// that is not configured is rejected, since "1.234" could be read either way.
+func applySeparators(input string, opts convertOptions) (string, error) {
+	decimal, thousand := opts.separators()
+	if decimal == thousand {
+		return "", newInvalidInputError(input, reasonSameSeparators, decimal)
+	}
+	if decimal == '.' && thousand == ',' {
+		return input, nil
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(input))
+	for _, r := range input {
+		switch r {
+		case decimal:
+			builder.WriteByte('.')
+		case thousand:
+			builder.WriteByte(',')
+		case '.', ',':
+			return "", newInvalidInputError(input, reasonAmbiguousSeparator, r, decimal, thousand)
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String(), nil
+}
+
+// validateGrouping checks that the commas in the integer part of input
+// separate groups of three digits after a leading group of one to three
+func validateGrouping(input string) error {
+	integer, _, _ := strings.Cut(strings.TrimSpace(input), ".")
+	integer = strings.TrimLeft(integer, "+-")
+	if !strings.Contains(integer, ",") {
+		return nil
+	}
+
+	for i, group := range strings.Split(integer, ",") {
+		size := utf8.RuneCountInString(group)
+		if size == 3 || (i == 0 && size >= 1 && size <= 3) {
+			continue
+		}
+		return newInvalidInputError(input, reasonMalformedGrouping, i+1, size)
+	}
+	return nil
+}
+
+// rejectCommas returns an invalid character error for the first comma in
+// input, naming separator, the thousand separator the caller typed
+func rejectCommas(input string, separator rune) error {
+	position := 0
+	for _, r := range input {
+		if r == ',' {
+			return newInvalidInputError(input, reasonInvalidCharacter, separator, separator, position)
+		}
+		position++
+	}
+	return nil
+}
+
+// maxExponent bounds scientific notation exponents so inputs like 1e999999999
+// cannot expand into huge strings
+const maxExponent = 1000
+
+// expandExponent rewrites a number in scientific notation, such as "2.3E-2",
+// as a plain decimal string such as "0.023" by moving the decimal point
+func expandExponent(input string) (string, error) {
+	mantissa, exponentStr, _ := strings.Cut(strings.ToLower(input), "e")
+
+	exponent, err := strconv.Atoi(exponentStr)
+	if err != nil {
+		return "", newInvalidInputError(input, reasonInvalidExponent)
+	}
+	if exponent > maxExponent || exponent < -maxExponent {
+		return "", newInvalidInputError(input, reasonExponentRange, maxExponent, maxExponent)
+	}
+
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") || strings.HasPrefix(mantissa, "+") {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	integerPart, fractionPart, _ := strings.Cut(mantissa, ".")
+	digits := integerPart + fractionPart
+	if digits == "" || !isValidNumber(digits) {
+		return "", newInvalidInputError(input, reasonInvalidMantissa)
+	}
+
+	point := len(integerPart) + exponent
+	switch {
+	case point <= 0:
+		return sign + "0." + strings.Repeat("0", -point) + digits, nil
+	case point >= len(digits):
+		return sign + digits + strings.Repeat("0", point-len(digits)), nil
+	default:
+		return sign + digits[:point] + "." + digits[point:], nil
+	}
+}
+
+// normalizeThaiDigit maps the Thai digits ๐-๙ to ASCII 0-9
+func normalizeThaiDigit(r rune) rune {
+	if r >= '๐' && r <= '๙' {
+		return '0' + (r - '๐')
+	}
+	return r
 }
 
 func isValidNumber(str string) bool {
@@ -114,24 +362,96 @@ func isValidNumber(str string) bool {
 
 type DecimalRoundingMode int
 
+// Rounding modes decide what happens to digits beyond satang. RoundHalf,
+// RoundDown and RoundUp work on the magnitude, so they are symmetric around
+// zero; RoundCeil and RoundFloor follow the number line instead:
+//
+//	        RoundDown RoundUp RoundCeil RoundFloor
+//	 1.234  1.23      1.24    1.24      1.23
+//	-1.234 -1.23     -1.24   -1.23     -1.24
+//
+// The half modes differ only on exact ties of negative amounts:
+//
+//	        RoundHalf RoundHalfUp
+//	 1.235  1.24      1.24
+//	-1.235 -1.24     -1.23
 const (
-	RoundHalf DecimalRoundingMode = iota
-	RoundDown
-	RoundUp
+	RoundHalf   DecimalRoundingMode = iota // half away from zero
+	RoundDown                              // toward zero
+	RoundUp                                // away from zero
+	RoundCeil                              // toward +∞
+	RoundFloor                             // toward −∞
+	RoundHalfUp                            // half toward +∞
 )
 
+// RoundHalfAwayFromZero is RoundHalf under its explicit name
+const RoundHalfAwayFromZero = RoundHalf
+
+// roundHalfDown rounds ties toward zero; it is the magnitude mode RoundHalfUp
+// resolves to for negative amounts and is not accepted from callers
+const roundHalfDown = RoundHalfUp + 1
+
+// roundingModeNames maps the names accepted by ParseRoundingMode to modes
+var roundingModeNames = map[string]DecimalRoundingMode{
+	"half":  RoundHalf,
+	"down":  RoundDown,
+	"up":    RoundUp,
+	"ceil":  RoundCeil,
+	"floor": RoundFloor,
+
+	"halfup":           RoundHalfUp,
+	"halfawayfromzero": RoundHalfAwayFromZero,
+}
+
+// ParseRoundingMode parses a rounding mode name such as "half" or "RoundHalf",
+// ignoring case, for reading modes from configuration files and struct tags
+func ParseRoundingMode(name string) (DecimalRoundingMode, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.TrimPrefix(key, "round")
+	if mode, ok := roundingModeNames[key]; ok {
+		return mode, nil
+	}
+	return RoundHalf, newInvalidInputError(name, reasonUnknownModeName)
+}
+
+// forMagnitude returns the mode to apply to the magnitude of a number with the
+// given sign, resolving RoundCeil and RoundFloor to RoundUp or RoundDown and
+// RoundHalfUp to RoundHalf or roundHalfDown
+func (m DecimalRoundingMode) forMagnitude(negative bool) DecimalRoundingMode {
+	switch m {
+	case RoundHalfUp:
+		if negative {
+			return roundHalfDown
+		}
+		return RoundHalf
+	case RoundCeil:
+		if negative {
+			return RoundDown
+		}
+		return RoundUp
+	case RoundFloor:
+		if negative {
+			return RoundUp
+		}
+		return RoundDown
+	}
+	return m
+}
+
 // MaxSupportedValue is the maximum number we can reliably convert to Thai text
 // This is set to 9,223,372,036,854,775,807 (19 digits) which is int64 maximum
 // and a practical limit for Thai currency representation
 const MaxSupportedValue = "9223372036854775807"
 
-var digitNames = map[int]string{
-	1: "หนึ่ง", 2: "สอง", 3: "สาม", 4: "สี่", 5: "ห้า",
-	6: "หก", 7: "เจ็ด", 8: "แปด", 9: "เก้า",
+// digitNames and unitNames are arrays rather than maps because they are
+// indexed for every digit read. Zero has no digit name; its word comes from
+// Lexicon.Digit(0).
+var digitNames = [10]string{
+	"", "หนึ่ง", "สอง", "สาม", "สี่", "ห้า", "หก", "เจ็ด", "แปด", "เก้า",
 }
 
-var unitNames = map[int]string{
-	0: "", 1: "สิบ", 2: "ร้อย", 3: "พัน", 4: "หมื่น", 5: "แสน", 6: "ล้าน",
+var unitNames = [7]string{
+	"", "สิบ", "ร้อย", "พัน", "หมื่น", "แสน", "ล้าน",
 }
 
 // EnableWarningLogs controls whether warning logs are printed when satang is capped at 99
@@ -150,10 +470,439 @@ func SetAllowOverflow(enabled bool) {
 	AllowOverflow = enabled
 }
 
+// NegativeStyle selects how a reading marks a negative amount
+type NegativeStyle int
+
+const (
+	// NegativeWord reads "ลบ", or Config.NegativePrefix, before the amount
+	NegativeWord NegativeStyle = iota
+	// NegativeParentheses wraps the whole reading in parentheses, as in
+	// accounting exports: -100 -> "(หนึ่งร้อยบาทถ้วน)"
+	NegativeParentheses
+)
+
+// ZeroSatangStyle selects how whole amounts end
+type ZeroSatangStyle int
+
+const (
+	// SuffixThuan ends whole amounts with "ถ้วน": "หนึ่งร้อยบาทถ้วน"
+	SuffixThuan ZeroSatangStyle = iota
+	// ExplicitZeroSatang reads the zero satang instead, as in some legal
+	// documents: "หนึ่งร้อยบาทศูนย์สตางค์"
+	ExplicitZeroSatang
+)
+
+// SatangStyle selects how the satang of a fractional amount joins the baht
+type SatangStyle int
+
+const (
+	// SatangStandard reads the satang right after the baht:
+	// "หนึ่งร้อยบาทห้าสิบสตางค์"
+	SatangStandard SatangStyle = iota
+	// SatangRemainder writes "เศษ" between the baht and the satang, as in
+	// some contracts: "หนึ่งร้อยบาทเศษห้าสิบสตางค์"
+	SatangRemainder
+	// SatangCustomConnector writes Config.SatangConnector between the baht
+	// and the satang, e.g. "กับ" for "หนึ่งร้อยบาทกับห้าสิบสตางค์"
+	SatangCustomConnector
+)
+
 type Config struct {
 	EnableWarningLogs bool
 	AllowOverflow     bool
 	DefaultRounding   DecimalRoundingMode
+
+	// Logger receives warnings, such as satang capped at 99, as structured
+	// records so services can route them. When nil, warnings are written
+	// with log.Printf if EnableWarningLogs is set; EnableWarningLogs does not
+	// gate a Logger, whose level filtering applies instead.
+	Logger *slog.Logger
+
+	// OnRound is called with the sanitized input and the rounded amount,
+	// e.g. "0.456" and "0.46", whenever rounding discards non-zero digits
+	// beyond satang, so a UI can show that the amount was rounded. With
+	// CashRounding the rounded amount is the final cash amount.
+	OnRound func(original, rounded string)
+
+	// SpaceBeforeMillion inserts a space before each "ล้าน" group suffix to
+	// make very large numbers easier to read on screen
+	SpaceBeforeMillion bool
+
+	// MillionRepeatSeparator is inserted between consecutive "ล้าน" words,
+	// e.g. " " reads 10^12 as "หนึ่งล้าน ล้าน". Empty keeps them joined.
+	MillionRepeatSeparator string
+
+	// VerboseZeros voices zeros between the first and last non-zero digits as
+	// "ศูนย์" plus their unit, e.g. 101 -> "หนึ่งร้อยศูนย์สิบเอ็ด", for
+	// educational readings. A zero just before "ล้าน" has no unit and stays
+	// silent, so 10,000,001 reads "สิบล้านศูนย์แสน…".
+	VerboseZeros bool
+
+	// ErrorOnRounding returns an ErrorCodeRoundingOccurred error instead of
+	// silently rounding when the input has non-zero digits beyond satang
+	ErrorOnRounding bool
+
+	// NegativePrefix is the word read before negative amounts. Empty means "ลบ".
+	NegativePrefix string
+
+	// NegativeStyle selects how negative amounts are marked. Parentheses
+	// wrap the complete reading including Suffix, and replace NegativePrefix.
+	NegativeStyle NegativeStyle
+
+	// ShowPositivePrefix reads "บวก" before amounts above zero, for ledgers
+	// that mark both signs: "+150" -> "บวกหนึ่งร้อยห้าสิบบาทถ้วน". Zero has
+	// no sign and reads as usual.
+	ShowPositivePrefix bool
+
+	// Suffix is appended verbatim after the complete reading, including any
+	// negative prefix, e.g. " (รวมภาษีมูลค่าเพิ่ม)" for VAT-inclusive amounts
+	Suffix string
+
+	// OmitEvenSuffix drops the "ถ้วน" after whole amounts, including amounts
+	// with zero satang such as "100.00" and amounts whose satang round to
+	// zero: 100 -> "หนึ่งร้อยบาท"
+	OmitEvenSuffix bool
+
+	// LegalNumerals appends the amount in grouped numerals after the reading,
+	// as written on contracts and cheques: -1234.5 ->
+	// "ลบหนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์ (-1,234.50)"
+	LegalNumerals bool
+
+	// TieBreaker decides exact satang ties under RoundHalf, such as 0.455. It
+	// receives the truncated satang value (45) and returns the value to use
+	// (45 or 46); any other result is an ErrorCodeInvalidInput error. Nil
+	// rounds ties up.
+	TieBreaker func(value int) int
+
+	// CashRounding rounds the whole amount to a cash increment expressed in
+	// baht (e.g. "0.25") before reading, using the active rounding mode.
+	// Empty means amounts are read exactly to the satang. NewConverter panics
+	// if the increment is not a positive whole number of satang or
+	// MinorUnitDigits is not the default of 2.
+	CashRounding string
+
+	// CurrencyFirst leads with the currency word for TTS scripts that speak it
+	// first, e.g. 123.45 -> "บาท หนึ่งร้อยยี่สิบสาม สี่สิบห้าสตางค์"
+	CurrencyFirst bool
+
+	// SatangFirst also leads the satang reading with its unit word when
+	// CurrencyFirst is set, e.g. "บาท หนึ่งร้อยยี่สิบสาม สตางค์ สี่สิบห้า"
+	SatangFirst bool
+
+	// SatangAsDecimal reads satang digit by digit after "บาทจุด" in the
+	// colloquial register, e.g. 100.45 -> "หนึ่งร้อยบาทจุดสี่ห้า". Whole
+	// amounts still end in "ถ้วน".
+	SatangAsDecimal bool
+
+	// ZeroSatangStyle selects how whole amounts end, including integer inputs
+	// and amounts whose satang round up into the next baht. Set
+	// ExplicitZeroSatang for a uniform "ศูนย์สตางค์" regardless of input type.
+	ZeroSatangStyle ZeroSatangStyle
+
+	// SatangStyle and SatangConnector set a word between the baht and the
+	// spelled satang to match a house style; see the SatangStyle constants
+	// for the exact words. They apply whenever satang are spelled, including
+	// ExplicitZeroSatang, but not to SatangAsDecimal or SatangAsFraction.
+	SatangStyle     SatangStyle
+	SatangConnector string
+
+	// SatangAsFraction writes satang as a cheque-style fraction after the
+	// spelled baht, e.g. 100.45 -> "หนึ่งร้อยบาท 45/100". Whole amounts end
+	// in "ถ้วน" unless FractionForEven is set, which writes " 00/100" instead.
+	SatangAsFraction bool
+	FractionForEven  bool
+
+	// ElideLeadingOne drops the "หนึ่ง" of a leading 1 in the ร้อย, พัน, หมื่น
+	// or แสน place, as in everyday speech: 100000 -> "แสนบาทถ้วน",
+	// 147521 -> "แสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทถ้วน"
+	ElideLeadingOne bool
+
+	// Lexicon spells digits and units in another language that shares the
+	// Thai grouping rules, such as the Lao lexicon in the lao subpackage.
+	// Nil means ThaiLexicon.
+	Lexicon Lexicon
+
+	// DigitWords and UnitWords replace the words for the digits 0-9 and the
+	// places สิบ through ล้าน, for regional spellings, keeping the grouping
+	// rules. UnitWords[0] is the ones place and is normally empty. Tens keep
+	// their irregular forms: 10 reads UnitWords[1] alone, 20 reads "ยี่"
+	// before it, and a trailing 1 reads "เอ็ด" or the Lexicon's word.
+	// All-empty arrays keep the Lexicon's words; NewConverter panics if an
+	// array is only partly filled.
+	DigitWords [10]string
+	UnitWords  [7]string
+
+	// MinorUnitDigits is the number of minor unit digits amounts are rounded
+	// and read to, from 1 to 6. Zero means 2, as for satang; 3 suits
+	// currencies with 1000 minor units, e.g. 1.123 -> "หนึ่งบาทหนึ่งร้อยยี่สิบสามสตางค์".
+	// CashRounding needs the default of 2.
+	MinorUnitDigits int
+
+	// DecimalSeparator and ThousandSeparator set the separators of string
+	// input, e.g. ',' and '.' for "1.234.567,89". Zero values mean '.' and
+	// ','. The separators must differ, and a '.' or ',' that is not one of
+	// them is rejected as ambiguous.
+	DecimalSeparator  rune
+	ThousandSeparator rune
+
+	// TrimFractionZeros drops trailing zeros from the decimals read by the
+	// ConvertNumber family, so 3.10 reads "สามจุดหนึ่ง" instead of
+	// "สามจุดหนึ่งศูนย์". Leading zeros such as the 0 in 3.05 are always read.
+	TrimFractionZeros bool
+
+	// ExpandMagnitudeWords accepts string input that mixes digits with the
+	// Thai magnitude words ร้อย, พัน, หมื่น, แสน and ล้าน for quick data entry,
+	// e.g. "5แสน" -> "ห้าแสนบาทถ้วน" and "2ล้าน5แสน" -> "สองล้านห้าแสนบาทถ้วน".
+	ExpandMagnitudeWords bool
+
+	// MaxValue lowers the largest amount the converter accepts, e.g.
+	// "9999999.99" for a retail system, so larger amounts fail early with an
+	// ErrorCodeExceedsMaxValue error. Amounts are compared by magnitude after
+	// rounding. Empty means MaxSupportedValue; NewConverter panics if the
+	// value is not a valid non-negative number.
+	MaxValue string
+
+	// StrictParsing rejects string input that is not a clean number, such
+	// as "1..2", "1_2", " 12", "+12" or ".5", instead of normalizing it. Only
+	// an optional minus sign, ASCII digits grouped by commas in threes and a
+	// decimal point are accepted.
+	StrictParsing bool
+
+	// ValidateGrouping rejects string input whose commas do not separate
+	// groups of exactly three digits, such as "1,00,000", instead of
+	// stripping them. The leading group may have one to three digits.
+	ValidateGrouping bool
+
+	// RejectCommas turns off comma stripping, so string input holding a
+	// thousand separator, such as "12,34" from a misconfigured locale, fails
+	// with an invalid character error instead of reading as 1234. The zero
+	// value keeps stripping commas.
+	RejectCommas bool
+
+	// StripCurrencyMarkers removes a baht marker, ฿, THB or บาท, from
+	// either end of string input before it is parsed, so pasted amounts
+	// such as "฿1,234.50" and "1234.50 THB" read as numbers
+	StripCurrencyMarkers bool
+
+	// TreatEmptyAsZero reads empty and whitespace-only strings as zero
+	// instead of returning ErrorCodeInvalidInput, e.g. for blank cells in
+	// imported spreadsheets
+	TreatEmptyAsZero bool
+
+	// ErrorLanguage selects the language of ConversionError messages and
+	// hints: "en" (the default when empty) or "th". Error codes are the same
+	// in every language.
+	ErrorLanguage string
+
+	// MajorUnit, MinorUnit and EvenSuffix replace "บาท", "สตางค์" and "ถ้วน"
+	// so a converter can read other currencies in Thai, e.g. "ดอลลาร์" and
+	// "เซ็นต์". Empty fields keep the baht words.
+	MajorUnit  string
+	MinorUnit  string
+	EvenSuffix string
+
+	// EnglishUnits sets the currency words used by ConvertEnglish. Nil means
+	// EnglishBaht.
+	EnglishUnits *EnglishUnits
+}
+
+// convertOptions holds the per-call settings derived from a Config, so
+// conversions never read or write the package-level settings directly.
+// The zero value reproduces the standard reading with overflow and warning
+// logs disabled.
+type convertOptions struct {
+	enableWarningLogs      bool
+	logger                 *slog.Logger
+	onRound                func(original, rounded string)
+	allowOverflow          bool
+	spaceBeforeMillion     bool
+	millionRepeatSeparator string
+	omitEvenSuffix         bool
+	verboseZeros           bool
+	elideLeadingOne        bool
+	errorOnRounding        bool
+	negativePrefix         string
+	negativeStyle          NegativeStyle
+	showPositivePrefix     bool
+	legalNumerals          bool
+	suffix                 string
+	currencyFirst          bool
+	satangFirst            bool
+	satangAsDecimal        bool
+	explicitZeroSatang     bool
+	satangConnector        string
+	satangAsFraction       bool
+	fractionForEven        bool
+	currency               Currency // zero value means THB
+	lexicon                Lexicon  // nil means ThaiLexicon
+	cashRounding           string
+	tieBreaker             func(value int) int
+	ctx                    context.Context // nil when the conversion cannot be canceled
+	errorLanguage          string
+	treatEmptyAsZero       bool
+	strictParsing          bool
+	validateGrouping       bool
+	rejectCommas           bool
+	stripCurrencyMarkers   bool
+	minorUnitDigits        int  // zero means 2
+	decimalSeparator       rune // zero means '.'
+	thousandSeparator      rune // zero means ','
+	trimFractionZeros      bool
+	expandMagnitudeWords   bool
+	maxValue               string // unsigned digits without commas; empty means MaxSupportedValue
+	approximate            bool   // ConvertApprox dropped digits, so "เศษ" replaces the satang
+}
+
+func (c *Config) options() convertOptions {
+	return convertOptions{
+		enableWarningLogs:      c.EnableWarningLogs,
+		logger:                 c.Logger,
+		onRound:                c.OnRound,
+		allowOverflow:          c.AllowOverflow,
+		spaceBeforeMillion:     c.SpaceBeforeMillion,
+		millionRepeatSeparator: c.MillionRepeatSeparator,
+		verboseZeros:           c.VerboseZeros,
+		elideLeadingOne:        c.ElideLeadingOne,
+		errorOnRounding:        c.ErrorOnRounding,
+		negativePrefix:         c.NegativePrefix,
+		negativeStyle:          c.NegativeStyle,
+		showPositivePrefix:     c.ShowPositivePrefix,
+		legalNumerals:          c.LegalNumerals,
+		suffix:                 c.Suffix,
+		omitEvenSuffix:         c.OmitEvenSuffix,
+		currencyFirst:          c.CurrencyFirst,
+		satangFirst:            c.SatangFirst,
+		satangAsDecimal:        c.SatangAsDecimal,
+		explicitZeroSatang:     c.ZeroSatangStyle == ExplicitZeroSatang,
+		satangConnector:        c.satangConnector(),
+		satangAsFraction:       c.SatangAsFraction,
+		fractionForEven:        c.FractionForEven,
+		currency:               c.currency(),
+		lexicon:                c.lexicon(),
+		cashRounding:           c.CashRounding,
+		tieBreaker:             c.TieBreaker,
+		errorLanguage:          c.ErrorLanguage,
+		treatEmptyAsZero:       c.TreatEmptyAsZero,
+		strictParsing:          c.StrictParsing,
+		validateGrouping:       c.ValidateGrouping,
+		rejectCommas:           c.RejectCommas,
+		stripCurrencyMarkers:   c.StripCurrencyMarkers,
+		minorUnitDigits:        c.MinorUnitDigits,
+		decimalSeparator:       c.DecimalSeparator,
+		thousandSeparator:      c.ThousandSeparator,
+		trimFractionZeros:      c.TrimFractionZeros,
+		expandMagnitudeWords:   c.ExpandMagnitudeWords,
+		maxValue:               c.maxValue(),
+	}
+}
+
+// lexicon returns the configured Lexicon with DigitWords and UnitWords
+// applied, or nil for ThaiLexicon
+func (c *Config) lexicon() Lexicon {
+	if c.DigitWords[1] == "" && c.UnitWords[1] == "" {
+		return c.Lexicon
+	}
+	base := c.Lexicon
+	if base == nil {
+		base = ThaiLexicon
+	}
+	return vocabularyLexicon{base: base, digits: c.DigitWords, units: c.UnitWords}
+}
+
+// satangConnector returns the word SatangStyle puts before the satang
+func (c *Config) satangConnector() string {
+	switch c.SatangStyle {
+	case SatangRemainder:
+		if lex := c.lexicon(); lex != nil {
+			return lex.Remainder()
+		}
+		return ThaiLexicon.Remainder()
+	case SatangCustomConnector:
+		return c.SatangConnector
+	default:
+		return ""
+	}
+}
+
+// maxValue returns MaxValue as unsigned digits without commas, or "" when
+// it is unset. NewConverter has already rejected invalid values.
+func (c *Config) maxValue() string {
+	if c.MaxValue == "" {
+		return ""
+	}
+	limit, _, err := validateAmount(c.MaxValue)
+	if err != nil {
+		return ""
+	}
+	return limit
+}
+
+// currency returns the unit words configured on c, falling back to THB
+func (c *Config) currency() Currency {
+	currency := THB
+	if c.MajorUnit != "" {
+		currency.Unit = c.MajorUnit
+	}
+	if c.MinorUnit != "" {
+		currency.SubUnit = c.MinorUnit
+	}
+	if c.EvenSuffix != "" {
+		currency.Even = c.EvenSuffix
+	}
+	return currency
+}
+
+// words returns the lexicon to read digits with, defaulting to ThaiLexicon
+func (o convertOptions) words() Lexicon {
+	if o.lexicon == nil {
+		return ThaiLexicon
+	}
+	return o.lexicon
+}
+
+// minusWord returns the word read before negative amounts: NegativePrefix,
+// or the lexicon's minus word when it is empty
+func (o convertOptions) minusWord() string {
+	if o.negativePrefix != "" {
+		return o.negativePrefix
+	}
+	return o.words().Minus()
+}
+
+// minorDigits returns the number of minor unit digits to round to
+func (o convertOptions) minorDigits() int {
+	if o.minorUnitDigits == 0 {
+		return 2
+	}
+	return o.minorUnitDigits
+}
+
+// separators returns the decimal and thousand separators of string input
+func (o convertOptions) separators() (rune, rune) {
+	decimal, thousand := o.decimalSeparator, o.thousandSeparator
+	if decimal == 0 {
+		decimal = '.'
+	}
+	if thousand == 0 {
+		thousand = ','
+	}
+	return decimal, thousand
+}
+
+// units returns the currency words to read with, defaulting to THB
+func (o convertOptions) units() Currency {
+	if o.currency.Unit == "" {
+		return THB
+	}
+	return o.currency
+}
+
+// globalOptions snapshots the package-level settings used by the global functions
+func globalOptions() convertOptions {
+	return convertOptions{
+		enableWarningLogs: EnableWarningLogs,
+		allowOverflow:     AllowOverflow,
+	}
 }
 
 func DefaultConfig() *Config {
@@ -166,45 +915,77 @@ func DefaultConfig() *Config {
 
 type Converter struct {
 	config *Config
+	cache  *lruCache // nil unless created by NewCachingConverter
 }
 
-// NewConverter creates a new converter with the specified configuration
+// NewConverter creates a new converter with the specified configuration. A
+// nil config means DefaultConfig. It panics if config is invalid, as
+// described for Config.MaxValue, DigitWords and UnitWords.
 func NewConverter(config *Config) *Converter {
-	if config == nil {
-		config = DefaultConfig()
-	}
-	return &Converter{config: config}
+	return &Converter{config: checkedConfig(config)}
 }
 
 func NewDefaultConverter() *Converter {
 	return NewConverter(DefaultConfig())
 }
 
+// SetConfig replaces the configuration of c and clears its cache, whose
+// readings were made with the old one, so a long-running service can
+// reconfigure a converter in place. It validates config like NewConverter.
+// SetConfig must not be called while c is converting on another goroutine.
+func (c *Converter) SetConfig(config *Config) {
+	c.config = checkedConfig(config)
+	c.Reset()
+}
+
+// checkedConfig returns config, or DefaultConfig when it is nil, and panics
+// if it is invalid
+func checkedConfig(config *Config) *Config {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if config.MaxValue != "" {
+		if _, negative, err := validateAmount(config.MaxValue); err != nil || negative {
+			panic(fmt.Sprintf("thbtextizer: invalid Config.MaxValue %q", config.MaxValue))
+		}
+	}
+	if err := validateWords(config.DigitWords, config.UnitWords); err != nil {
+		panic("thbtextizer: invalid Config." + err.Error())
+	}
+	if config.CashRounding != "" {
+		if _, err := cashIncrement(config.CashRounding); err != nil {
+			panic(fmt.Sprintf("thbtextizer: invalid Config.CashRounding %q: %v", config.CashRounding, err))
+		}
+		if config.MinorUnitDigits != 0 && config.MinorUnitDigits != 2 {
+			panic(fmt.Sprintf("thbtextizer: Config.CashRounding requires MinorUnitDigits 2, got %d", config.MinorUnitDigits))
+		}
+	}
+	return config
+}
+
 // Convert converts a numeric amount to Thai Baht text using instance configuration
 func (c *Converter) Convert(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
+	if c.cache != nil {
+		return c.convertCached(amount, roundingMode)
+	}
+	return c.convertWithOptions(amount, roundingMode, c.config.options())
+}
+
+// convertWithOptions runs a conversion with the instance settings applied
+func (c *Converter) convertWithOptions(amount any, roundingMode []DecimalRoundingMode, opts convertOptions) (string, error) {
 	// Use instance configuration
 	mode := c.config.DefaultRounding
 	if len(roundingMode) > 0 {
 		mode = roundingMode[0]
 	}
 
-	// Use instance-specific settings
-	originalWarningLogs := EnableWarningLogs
-	originalAllowOverflow := AllowOverflow
-
-	EnableWarningLogs = c.config.EnableWarningLogs
-	AllowOverflow = c.config.AllowOverflow
-
-	// Ensure we restore original settings
-	defer func() {
-		EnableWarningLogs = originalWarningLogs
-		AllowOverflow = originalAllowOverflow
-	}()
-
-	return convertWithMode(amount, mode)
+	return convertWithMode(amount, mode, opts)
 }
 
-// Convert is the global function that maintains backward compatibility
+// Convert is the global function that maintains backward compatibility.
+// Floats are read as their shortest decimal form, so 0.1+0.2 is read as
+// 0.30000000000000004 and RoundUp gives 31 satang; pass a string such as
+// "0.3" when the exact decimal matters.
 func Convert(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
 	// Default to RoundHalf if no mode specified
 	mode := RoundHalf
@@ -212,80 +993,631 @@ func Convert(amount any, roundingMode ...DecimalRoundingMode) (string, error) {
 		mode = roundingMode[0]
 	}
 
-	return convertWithMode(amount, mode)
+	return convertWithMode(amount, mode, globalOptions())
 }
 
-// convertWithMode is the core conversion logic extracted for reuse
-func convertWithMode(amount any, mode DecimalRoundingMode) (string, error) {
+// MustConvert is like Convert but panics if the amount cannot be converted.
+// It is meant only for trusted inputs such as constants in initialization
+// code, in the way of regexp.MustCompile; never pass it user input.
+func MustConvert(amount any, roundingMode ...DecimalRoundingMode) string {
+	text, err := Convert(amount, roundingMode...)
+	if err != nil {
+		panic(fmt.Sprintf("thbtextizer: MustConvert(%v): %v", amount, err))
+	}
+	return text
+}
 
-	// Convert any numeric type to string
-	amountStr, err := convertToString(amount)
+// MustConvert is like Converter.Convert but panics if the amount cannot be
+// converted. It is meant only for trusted inputs.
+func (c *Converter) MustConvert(amount any, roundingMode ...DecimalRoundingMode) string {
+	text, err := c.Convert(amount, roundingMode...)
 	if err != nil {
-		return "", err
+		panic(fmt.Sprintf("thbtextizer: MustConvert(%v): %v", amount, err))
 	}
+	return text
+}
 
-	// Sanitize and validate input
-	amountStr, err = sanitizeInput(amountStr)
+// convertWithMode is the core conversion logic extracted for reuse
+func convertWithMode(amount any, mode DecimalRoundingMode, opts convertOptions) (string, error) {
+	normalized, err := prepareAmount(amount, mode, opts)
 	if err != nil {
 		return "", err
 	}
 
-	// Remove commas from input (e.g., "1,234,567" -> "1234567")
-	amountStr = strings.ReplaceAll(amountStr, ",", "")
+	return renderAmount(normalized, opts), nil
+}
 
-	// Validate that the number doesn't exceed our maximum supported value
-	if err := validateMaxValue(amountStr); err != nil {
-		return "", err
+// prepareAmount normalizes amount and applies the rounding checks and cash
+// rounding configured in opts, leaving it ready to render
+func prepareAmount(amount any, mode DecimalRoundingMode, opts convertOptions) (normalizedAmount, error) {
+	normalized, err := normalizeAmount(amount, mode, opts)
+	if err != nil {
+		return normalizedAmount{}, localizeError(err, opts.errorLanguage)
+	}
+
+	if opts.errorOnRounding && normalized.rounded {
+		return normalizedAmount{}, localizeError(newRoundingOccurredError(normalized.input, opts.minorDigits()), opts.errorLanguage)
+	}
+
+	if opts.cashRounding != "" {
+		if opts.minorDigits() != 2 {
+			return normalizedAmount{}, localizeError(newInvalidInputError(opts.cashRounding, reasonCashMinorDigits), opts.errorLanguage)
+		}
+		normalized, err = applyCashRounding(normalized, opts.cashRounding, mode)
+		if err != nil {
+			return normalizedAmount{}, localizeError(err, opts.errorLanguage)
+		}
+		// Rounding to the increment can carry an amount at MaxValue over it
+		if opts.maxValue != "" && exceedsLimit(normalized.integer+"."+normalized.decimal, opts.maxValue) {
+			return normalizedAmount{}, localizeError(newExceedsLimitError(normalized.input, opts.maxValue), opts.errorLanguage)
+		}
+		reportRounding(normalized, opts)
+	}
+
+	return normalized, nil
+}
+
+// normalizedAmount is an amount after sanitizing, validation and rounding
+type normalizedAmount struct {
+	input    string // sanitized input before rounding
+	signed   bool   // the input had a minus sign
+	negative bool   // the amount is below zero after rounding
+	integer  string // integer digits
+	decimal  string // two satang digits, or "" when the input had no decimals
+	rounded  bool   // non-zero digits beyond satang were rounded away
+	capped   bool   // satang were capped at 99 instead of carrying into baht
+}
+
+// isEven reports whether n has no minor units
+func (n normalizedAmount) isEven() bool {
+	return strings.Trim(n.decimal, "0") == ""
+}
+
+// isZero reports whether the normalized digits are all zero
+func (n normalizedAmount) isZero() bool {
+	return strings.Trim(n.integer, "0") == "" && strings.Trim(n.decimal, "0") == ""
+}
+
+// decimalString formats n as a decimal string with two satang digits and
+// without leading zeros, e.g. "-123.45"
+func (n normalizedAmount) decimalString() string {
+	integer := strings.TrimLeft(n.integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+	decimal := n.decimal
+	if decimal == "" {
+		decimal = "00"
+	}
+	if n.negative {
+		return "-" + integer + "." + decimal
+	}
+	return integer + "." + decimal
+}
+
+// preprocessInput applies the string options in opts to input, rewriting it
+// into the standard "1,234.56" form read by both money and number mode
+func preprocessInput(input string, opts convertOptions) (string, error) {
+	if opts.stripCurrencyMarkers {
+		input = stripCurrencyMarkers(input)
+	}
+	if opts.decimalSeparator != 0 || opts.thousandSeparator != 0 {
+		standard, err := applySeparators(input, opts)
+		if err != nil {
+			return "", err
+		}
+		input = standard
+	}
+	if opts.expandMagnitudeWords {
+		expanded, err := expandMagnitudeWords(input)
+		if err != nil {
+			return "", err
+		}
+		input = expanded
+	}
+	if opts.treatEmptyAsZero && strings.TrimSpace(input) == "" {
+		return "0", nil
+	}
+	if opts.strictParsing && !strictNumber.MatchString(input) {
+		return "", newInvalidInputError(input, reasonStrictParsing)
+	}
+	if opts.validateGrouping {
+		if err := validateGrouping(input); err != nil {
+			return "", err
+		}
+	}
+	if opts.rejectCommas {
+		_, thousand := opts.separators()
+		if err := rejectCommas(input, thousand); err != nil {
+			return "", err
+		}
+	}
+	return input, nil
+}
+
+// normalizeAmount sanitizes, validates and rounds amount to satang
+func normalizeAmount(amount any, mode DecimalRoundingMode, opts convertOptions) (normalizedAmount, error) {
+	if s, ok := amount.(string); ok {
+		s, err := preprocessInput(s, opts)
+		if err != nil {
+			return normalizedAmount{}, err
+		}
+		amount = s
+	}
+
+	places := opts.minorDigits()
+	if places < 1 || places > 6 {
+		return normalizedAmount{}, newInvalidInputError(strconv.Itoa(places), reasonMinorDigitsRange)
+	}
+
+	if normalized, ok := smallIntAmount(amount, places, opts); ok {
+		return normalized, nil
+	}
+
+	amountStr, negative, err := validateAmount(amount)
+	if err != nil {
+		return normalizedAmount{}, err
 	}
 
 	parts := strings.Split(amountStr, ".")
-	integerPart := parts[0]
+
+	// Leading zeros carry no value but would shift the digit groups, making
+	// "001" read "เอ็ด"
+	integerPart := strings.TrimLeft(parts[0], "0")
+	if integerPart == "" {
+		integerPart = "0"
+	}
 
 	var decimalPart string
-	var overflow bool
+	var overflow, rounded, capped bool
 	if len(parts) > 1 {
-		decimalPart, overflow = formatDecimalPartWithRounding(parts[1], mode)
+		rounded = len(parts[1]) > places && strings.TrimRight(parts[1][places:], "0") != ""
+		decimalPart, overflow, capped, err = formatDecimalPartWithRounding(parts[1], mode, negative, opts)
+		if err != nil {
+			// Report the whole amount rather than its decimals
+			var convErr *ConversionError
+			if errors.As(err, &convErr) {
+				convErr.Input = amountStr
+			}
+			return normalizedAmount{}, err
+		}
 
 		// Handle overflow case where satang rounds up to 100
 		if overflow {
-			integerNum, err := strconv.Atoi(integerPart)
-			if err == nil {
-				decimalPart = "00" // Reset to 00 satang
-				integerPart = strconv.Itoa(integerNum + 1)
+			decimalPart = strings.Repeat("0", places) // Reset to 00 satang
+			integerPart = incrementDigits(integerPart)
+			if err := validateMaxValue(integerPart); err != nil {
+				return normalizedAmount{}, err
 			}
 		}
+	} else if places != 2 {
+		// Other digit counts always carry their minor digits, so the
+		// value and fraction forms show them
+		decimalPart = strings.Repeat("0", places)
 	}
 
-	var builder strings.Builder
-	builder.Grow(128)
+	normalized := normalizedAmount{
+		input:    amountStr,
+		signed:   negative,
+		negative: negative,
+		integer:  integerPart,
+		decimal:  decimalPart,
+		rounded:  rounded,
+		capped:   capped,
+	}
+	// Negative zero reads as plain zero
+	if normalized.isZero() {
+		normalized.negative = false
+	}
 
-	bahtText := convertIntegerNumber(integerPart)
-	if bahtText == "" {
-		builder.WriteString("ศูนย์")
-	} else {
-		builder.WriteString(bahtText)
+	if opts.maxValue != "" && exceedsLimit(integerPart+"."+decimalPart, opts.maxValue) {
+		return normalizedAmount{}, newExceedsLimitError(amountStr, opts.maxValue)
+	}
+
+	// With CashRounding the caller reports the final cash amount instead
+	if opts.cashRounding == "" {
+		reportRounding(normalized, opts)
+	}
+
+	return normalized, nil
+}
+
+// reportRounding passes the signed input and the rounded amount of n to
+// the OnRound callback when rounding discarded digits beyond satang
+func reportRounding(n normalizedAmount, opts convertOptions) {
+	if !n.rounded || opts.onRound == nil {
+		return
+	}
+	original := n.input
+	if n.signed {
+		original = "-" + original
+	}
+	opts.onRound(original, n.decimalString())
+}
+
+// smallIntAmount normalizes Go integers below a million in magnitude without
+// formatting, sanitizing and validating them as strings, since they cannot
+// be malformed, rounded or out of range. It reports false for every other
+// amount, and when a configured MaxValue needs checking.
+func smallIntAmount(amount any, places int, opts convertOptions) (normalizedAmount, bool) {
+	if opts.maxValue != "" {
+		return normalizedAmount{}, false
+	}
+
+	var value int64
+	switch v := amount.(type) {
+	case int:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case int64:
+		value = v
+	default:
+		return normalizedAmount{}, false
+	}
+	if value <= -1_000_000 || value >= 1_000_000 {
+		return normalizedAmount{}, false
+	}
+
+	negative := value < 0
+	if negative {
+		value = -value
+	}
+	digits := strconv.FormatInt(value, 10)
+
+	normalized := normalizedAmount{input: digits, negative: negative, integer: digits}
+	if places != 2 {
+		normalized.decimal = strings.Repeat("0", places)
+	}
+	return normalized, true
+}
+
+// Result is the reading of an amount split into its components, before the
+// currency words, negative prefix and suffix are attached
+type Result struct {
+	Negative   bool   // the amount is below zero after rounding
+	BahtText   string // integer reading, "ศูนย์" for zero
+	SatangText string // satang reading, "" when IsEven
+	IsEven     bool   // there is no satang, read as "ถ้วน"
+	Integer    string // normalized integer digits
+	Decimal    string // normalized satang digits, all zeros when IsEven
+}
+
+// ConvertParts converts amount like Convert but returns the reading split
+// into components, so callers can attach "บาท" and "สตางค์" with their own
+// spacing or styling
+func ConvertParts(amount any, roundingMode ...DecimalRoundingMode) (Result, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertParts(amount, mode, globalOptions())
+}
+
+// ConvertParts converts amount to its reading components using instance configuration
+func (c *Converter) ConvertParts(amount any, roundingMode ...DecimalRoundingMode) (Result, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertParts(amount, mode, c.config.options())
+}
+
+func convertParts(amount any, mode DecimalRoundingMode, opts convertOptions) (Result, error) {
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return readAmount(normalized, opts), nil
+}
+
+// ConvertWithValue converts amount like Convert and also returns the amount
+// it read as a decimal string with two satang digits, such as "100.99" for
+// "100.994". The value reflects rounding, overflow and cash rounding.
+func ConvertWithValue(amount any, roundingMode ...DecimalRoundingMode) (string, string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertWithValue(amount, mode, globalOptions())
+}
+
+// ConvertWithValue converts amount and returns the value it read using
+// instance configuration
+func (c *Converter) ConvertWithValue(amount any, roundingMode ...DecimalRoundingMode) (string, string, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertWithValue(amount, mode, c.config.options())
+}
+
+func convertWithValue(amount any, mode DecimalRoundingMode, opts convertOptions) (string, string, error) {
+	detail, err := convertDetailed(amount, mode, opts)
+	return detail.Text, detail.Value, err
+}
+
+// Detail is a reading together with what was read, for audit logs
+type Detail struct {
+	Text     string              // the reading returned by Convert
+	Value    string              // the normalized number that was read, e.g. "101.00"
+	Mode     DecimalRoundingMode // the rounding mode applied, after defaults
+	Capped   bool                // satang were capped at 99 instead of carrying into baht
+	Warnings []error             // non-fatal conditions, such as ErrorCodeSatangCapped
+}
+
+// ConvertDetailed converts amount like Convert and also returns the
+// normalized number that was spelled out, after commas are stripped and
+// rounding and overflow are applied: "100.995" with AllowOverflow reads
+// "หนึ่งร้อยเอ็ดบาทถ้วน" from "101.00". Audit logs can record the pair to
+// show exactly what was read; Value matches ConvertWithValue.
+//
+// Without AllowOverflow, "100.995" reads "100.99" instead. Capped is set
+// and Warnings holds an ErrorCodeSatangCapped error, so such records can be
+// flagged for review.
+//
+// Mode records the rounding mode that ran: RoundHalf unless one is passed
+// here, or Config.DefaultRounding for a Converter.
+func ConvertDetailed(amount any, roundingMode ...DecimalRoundingMode) (Detail, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertDetailed(amount, mode, globalOptions())
+}
+
+// ConvertDetailed converts amount and returns the normalized number it read
+// using instance configuration
+func (c *Converter) ConvertDetailed(amount any, roundingMode ...DecimalRoundingMode) (Detail, error) {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
 	}
-	builder.WriteString("บาท")
 
-	if decimalPart == "" || decimalPart == "00" {
-		builder.WriteString("ถ้วน")
+	return convertDetailed(amount, mode, c.config.options())
+}
+
+// convertDetailed is convertWithMode returning the normalized number and
+// any warnings as well
+func convertDetailed(amount any, mode DecimalRoundingMode, opts convertOptions) (Detail, error) {
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return Detail{}, err
+	}
+
+	detail := Detail{
+		Text:   renderAmount(normalized, opts),
+		Value:  normalized.decimalString(),
+		Mode:   mode,
+		Capped: normalized.capped,
+	}
+	if normalized.capped {
+		original := normalized.input
+		if normalized.negative {
+			original = "-" + original
+		}
+		detail.Warnings = append(detail.Warnings, localizeError(newSatangCappedError(original), opts.errorLanguage))
+	}
+
+	return detail, nil
+}
+
+// readAmount reads the digits of a normalized amount
+func readAmount(n normalizedAmount, opts convertOptions) Result {
+	result := Result{
+		Negative: n.negative,
+		Integer:  n.integer,
+		Decimal:  n.decimal,
+	}
+
+	lex := opts.words()
+	result.BahtText = convertIntegerNumber(n.integer, opts)
+	if result.BahtText == "" {
+		result.BahtText = lex.Digit(0)
+	}
+
+	if n.isEven() {
+		result.IsEven = true
+		result.Decimal = strings.Repeat("0", opts.minorDigits())
 	} else {
-		satangText := convertDecimalPart(decimalPart)
-		if satangText == "" {
-			builder.WriteString("ศูนย์")
-		} else {
-			builder.WriteString(satangText)
+		result.SatangText = readSatang(n.decimal, lex)
+		if result.SatangText == "" {
+			result.SatangText = lex.Digit(0)
 		}
-		builder.WriteString("สตางค์")
 	}
 
-	return builder.String(), nil
+	return result
+}
+
+// renderAmount writes the Thai reading of a normalized amount
+func renderAmount(n normalizedAmount, opts convertOptions) string {
+	return renderReading(readAmount(n, opts), opts.units(), opts)
+}
+
+// renderReading attaches the words of currency to a reading
+func renderReading(r Result, currency Currency, opts convertOptions) string {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	// bytes.Buffer never returns write errors
+	_ = writeReading(buf, r, currency, opts)
+
+	return buf.String()
+}
+
+// writeReading writes a reading with the words of currency attached to w
+func writeReading(w io.Writer, r Result, currency Currency, opts convertOptions) error {
+	out := &errWriter{w: w}
+
+	parenthesized := r.Negative && opts.negativeStyle == NegativeParentheses
+	if parenthesized {
+		out.writeByte('(')
+	}
+
+	if opts.currencyFirst {
+		out.writeString(currency.Unit)
+		out.writeByte(' ')
+	}
+
+	switch {
+	case r.Negative && !parenthesized:
+		out.writeString(opts.minusWord())
+	case !r.Negative && opts.showPositivePrefix && strings.Trim(r.Integer+r.Decimal, "0") != "":
+		out.writeString(opts.words().Plus())
+	}
+
+	out.writeString(r.BahtText)
+	if !opts.currencyFirst {
+		out.writeString(currency.Unit)
+	}
+
+	switch {
+	case opts.approximate:
+		out.writeString(opts.words().Remainder())
+	case r.IsEven && opts.satangAsFraction && opts.fractionForEven:
+		out.writeByte(' ')
+		out.writeString(r.Decimal)
+		out.writeString("/1")
+		out.writeString(strings.Repeat("0", len(r.Decimal)))
+	case r.IsEven && opts.explicitZeroSatang:
+		out.writeString(opts.satangConnector)
+		out.writeString(opts.words().Digit(0))
+		out.writeString(currency.SubUnit)
+	case r.IsEven:
+		if !opts.omitEvenSuffix {
+			out.writeString(currency.Even)
+		}
+	case opts.satangAsFraction:
+		out.writeByte(' ')
+		out.writeString(r.Decimal)
+		out.writeString("/1")
+		out.writeString(strings.Repeat("0", len(r.Decimal)))
+	case opts.satangAsDecimal:
+		out.writeString(opts.words().Point())
+		out.writeString(readDigits(opts.words(), r.Decimal))
+	case opts.currencyFirst && opts.satangFirst:
+		out.writeByte(' ')
+		out.writeString(opts.satangConnector)
+		out.writeString(currency.SubUnit)
+		out.writeByte(' ')
+		out.writeString(r.SatangText)
+	case opts.currencyFirst:
+		out.writeByte(' ')
+		out.writeString(opts.satangConnector)
+		out.writeString(r.SatangText)
+		out.writeString(currency.SubUnit)
+	default:
+		out.writeString(opts.satangConnector)
+		out.writeString(r.SatangText)
+		out.writeString(currency.SubUnit)
+	}
+
+	out.writeString(opts.suffix)
+	if parenthesized {
+		out.writeByte(')')
+	}
+
+	if opts.legalNumerals {
+		writeNumerals(out, r)
+	}
+
+	return out.err
+}
+
+// writeNumerals writes the amount of r in grouped numerals with two satang
+// digits inside parentheses, e.g. " (-1,234.50)"
+func writeNumerals(out *errWriter, r Result) {
+	integer := strings.TrimLeft(r.Integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+
+	out.writeString(" (")
+	if r.Negative {
+		out.writeByte('-')
+	}
+	out.writeString(groupThousands(integer))
+	out.writeByte('.')
+	out.writeString(r.Decimal)
+	out.writeByte(')')
+}
+
+// EstimateCost returns a cheap proxy for the work Convert would do for amount,
+// measured as the number of significant digits to be read. It only sanitizes
+// and validates the input, so it can be used to reject or price oversized
+// requests before converting them.
+func EstimateCost(amount any) (int, error) {
+	amountStr, _, err := validateAmount(amount)
+	if err != nil {
+		return 0, err
+	}
+
+	parts := strings.Split(amountStr, ".")
+	cost := len(strings.TrimLeft(parts[0], "0"))
+	if len(parts) > 1 {
+		cost += len(parts[1])
+	}
+	if cost == 0 {
+		cost = 1
+	}
+
+	return cost, nil
+}
+
+// Validate reports whether Convert accepts amount, returning the same
+// ConversionError Convert would without building the Thai text. It is cheap
+// enough to run on every keystroke of a form field. Amounts are rounded as
+// Convert rounds them by default, including a satang carry under
+// AllowOverflow, so a nil return guarantees that Convert succeeds for the
+// same input without a rounding mode. Another mode can still carry an
+// amount at the limit past MaxSupportedValue.
+func Validate(amount any) error {
+	opts := globalOptions()
+	opts.enableWarningLogs = false
+	_, err := normalizeAmount(amount, RoundHalf, opts)
+	return err
+}
+
+// validateAmount converts amount to a string, sanitizes it and checks it
+// against MaxSupportedValue, returning its unsigned digits without commas
+// and whether it was negative
+func validateAmount(amount any) (string, bool, error) {
+	// Convert any numeric type to string
+	amountStr, err := convertToString(amount)
+	if err != nil {
+		return "", false, err
+	}
+
+	// Sanitize and validate input
+	amountStr, negative, err := sanitizeInput(amountStr)
+	if err != nil {
+		return "", false, err
+	}
+
+	// Remove commas from input (e.g., "1,234,567" -> "1234567")
+	amountStr = strings.ReplaceAll(amountStr, ",", "")
+
+	// Validate that the number doesn't exceed our maximum supported value
+	if err := validateMaxValue(amountStr); err != nil {
+		return "", false, err
+	}
+
+	return amountStr, negative, nil
 }
 
 func convertToString(amount any) (string, error) {
 	switch v := amount.(type) {
 	case string:
 		return v, nil
+	case json.Number:
+		// Decoders using UseNumber keep the literal text, so no digit is lost
+		return v.String(), nil
 	case int:
 		return fmt.Sprintf("%d", v), nil
 	case int8:
@@ -307,14 +1639,38 @@ func convertToString(amount any) (string, error) {
 	case uint64:
 		return fmt.Sprintf("%d", v), nil
 	case float32:
-		return fmt.Sprintf("%.2f", v), nil
+		// Keep every digit so the rounding mode, not formatting, decides satang
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
 	case float64:
-		return fmt.Sprintf("%.2f", v), nil
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case *big.Float:
+		if v == nil {
+			return "", newInvalidInputError("<nil>", reasonNilBigFloat)
+		}
+		if v.IsInf() {
+			return "", newInvalidInputError(v.String(), reasonInfinite)
+		}
+		// Shortest exact decimal so rounding modes see every digit
+		return v.Text('f', -1), nil
 	default:
 		return "", newUnsupportedTypeError(fmt.Sprintf("%T", amount))
 	}
 }
 
+// incrementDigits adds one to a string of decimal digits, carrying through
+// any run of nines without the int64 limits of strconv
+func incrementDigits(digits string) string {
+	incremented := []byte(digits)
+	for i := len(incremented) - 1; i >= 0; i-- {
+		if incremented[i] < '9' {
+			incremented[i]++
+			return string(incremented)
+		}
+		incremented[i] = '0'
+	}
+	return "1" + string(incremented)
+}
+
 // validateMaxValue checks if the input number exceeds our maximum supported value
 func validateMaxValue(amountStr string) error {
 	// Extract just the integer part (before decimal point)
@@ -351,68 +1707,96 @@ func validateMaxValue(amountStr string) error {
 	return nil
 }
 
-func formatDecimalPartWithRounding(decimal string, roundingMode DecimalRoundingMode) (string, bool) {
-	if len(decimal) == 0 {
-		return "00", false
-	}
-	if len(decimal) == 1 {
-		return decimal + "0", false
+// exceedsLimit reports whether the unsigned decimal amount is above limit.
+// Both are digits with an optional decimal point and no commas.
+func exceedsLimit(amount, limit string) bool {
+	amountInteger, amountFraction, _ := strings.Cut(amount, ".")
+	limitInteger, limitFraction, _ := strings.Cut(limit, ".")
+	amountInteger = strings.TrimLeft(amountInteger, "0")
+	limitInteger = strings.TrimLeft(limitInteger, "0")
+
+	if len(amountInteger) != len(limitInteger) {
+		return len(amountInteger) > len(limitInteger)
 	}
-	if len(decimal) == 2 {
-		return decimal, false
+	if amountInteger != limitInteger {
+		return amountInteger > limitInteger
 	}
 
-	// Handle more than 2 decimal places with rounding
-	if len(decimal) > 2 {
-		// Get first 2 digits and the third digit for rounding decision
-		first2Digits := decimal[:2]
-		thirdDigit, _ := strconv.Atoi(string(decimal[2]))
+	// Pad the fractions to the same length so they compare as strings
+	width := max(len(amountFraction), len(limitFraction))
+	amountFraction += strings.Repeat("0", width-len(amountFraction))
+	limitFraction += strings.Repeat("0", width-len(limitFraction))
+	return amountFraction > limitFraction
+}
 
-		// Convert first 2 digits to integer for rounding calculation
-		value, _ := strconv.Atoi(first2Digits)
-		originalValue := value
-		warningMsg := "Warning: %s rounds to 100 satang, forced to round down to 99 satang to maintain currency format. Consider enabling AllowOverflow."
+// formatDecimalPartWithRounding rounds decimal to the minor unit digits. It
+// reports overflow when the digits carry into the next baht, and capped when
+// AllowOverflow is off so they were held at the largest value instead. It
+// returns an error when a TieBreaker picks a value other than rounding the
+// tie down or up.
+func formatDecimalPartWithRounding(decimal string, roundingMode DecimalRoundingMode, negative bool, opts convertOptions) (string, bool, bool, error) {
+	places := opts.minorDigits()
+	if len(decimal) <= places {
+		return decimal + strings.Repeat("0", places-len(decimal)), false, false, nil
+	}
 
-		switch roundingMode {
-		case RoundDown:
-			return first2Digits, false
-		case RoundUp:
-			if len(decimal) > 2 && thirdDigit > 0 {
-				value++
-				if value >= 100 {
-					if AllowOverflow {
-						return "00", true
-					} else {
-						if originalValue == 99 && EnableWarningLogs {
-							log.Printf(warningMsg, decimal)
-						}
-						value = 99
-					}
+	// Handle more decimal places than minor digits with rounding
+	kept := decimal[:places]
+	nextDigit := int(decimal[places] - '0')
+
+	// Convert the kept digits to integer for rounding calculation
+	value, _ := strconv.Atoi(kept)
+	originalValue := value
+	limit := int(pow10(places))
+	warningMsg := "Warning: %s rounds to %d satang, forced to round down to %d satang to maintain currency format. Consider enabling AllowOverflow."
+
+	switch roundingMode.forMagnitude(negative) {
+	case RoundDown:
+		return kept, false, false, nil
+	case RoundUp:
+		// Any non-zero digit past the minor digits rounds up, not just the next one
+		if strings.TrimRight(decimal[places:], "0") != "" {
+			value++
+		}
+	case RoundHalf:
+		if nextDigit >= 5 {
+			// An exact tie (nothing after the 5) goes to the tie breaker if one is set
+			if opts.tieBreaker != nil && nextDigit == 5 && strings.TrimRight(decimal[places+1:], "0") == "" {
+				value = opts.tieBreaker(value)
+				if value != originalValue && value != originalValue+1 {
+					return "", false, false, newInvalidInputError(decimal, reasonTieBreaker, value, originalValue, originalValue, originalValue+1)
 				}
-			}
-		case RoundHalf:
-			if thirdDigit >= 5 {
+			} else {
 				value++
-				if value >= 100 {
-					if AllowOverflow {
-						return "00", true
-					} else {
-						if originalValue == 99 && EnableWarningLogs {
-							log.Printf(warningMsg, decimal)
-						}
-						value = 99
-					}
-				}
 			}
 		}
+	case roundHalfDown:
+		// Only digits past an exact 5 round the magnitude up
+		if nextDigit > 5 || (nextDigit == 5 && strings.TrimRight(decimal[places+1:], "0") != "") {
+			value++
+		}
+	}
 
-		return fmt.Sprintf("%02d", value), false
+	if value >= limit {
+		if opts.allowOverflow {
+			return strings.Repeat("0", places), true, false, nil
+		}
+		if originalValue == limit-1 {
+			switch {
+			case opts.logger != nil:
+				opts.logger.Warn("satang capped to avoid rounding into the next baht",
+					"decimal", decimal, "capped", limit-1, "hint", "enable AllowOverflow")
+			case opts.enableWarningLogs:
+				log.Printf(warningMsg, decimal, limit, limit-1)
+			}
+		}
+		return fmt.Sprintf("%0*d", places, limit-1), false, true, nil
 	}
 
-	return decimal, false
+	return fmt.Sprintf("%0*d", places, value), false, false, nil
 }
 
-func convertIntegerNumber(numberStr string) string {
+func convertIntegerNumber(numberStr string, opts convertOptions) string {
 	if !isValidNumber(numberStr) {
 		return ""
 	}
@@ -422,7 +1806,23 @@ func convertIntegerNumber(numberStr string) string {
 		return ""
 	}
 
-	return buildThaiText(digits)
+	text := buildThaiText(digits, opts)
+	if opts.elideLeadingOne && hasElidableLeadingOne(digits) {
+		text = strings.TrimPrefix(text, opts.words().Digit(1))
+	}
+	return text
+}
+
+// hasElidableLeadingOne reports whether the most significant digit is a 1 in
+// the ร้อย, พัน, หมื่น or แสน place of its group
+func hasElidableLeadingOne(digits []int) bool {
+	for i, digit := range digits {
+		if digit != 0 {
+			unitIndex := (len(digits) - i - 1) % 6
+			return digit == 1 && unitIndex >= 2
+		}
+	}
+	return false
 }
 
 func parseDigits(numberStr string) []int {
@@ -434,157 +1834,181 @@ func parseDigits(numberStr string) []int {
 	return digits
 }
 
-// countNonZeroGroups counts how many 6-digit groups contain non-zero digits
-func countNonZeroGroups(digits []int) int {
+// hasNonZero reports whether any digit is not zero
+func hasNonZero(digits []int) bool {
+	for _, digit := range digits {
+		if digit != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func buildThaiText(digits []int, opts convertOptions) string {
 	digitCount := len(digits)
-	count := 0
-
-	for startPos := digitCount; startPos > 0; startPos -= 6 {
-		endPos := max(startPos-6, 0)
-		group := digits[endPos:startPos]
-
-		// Check if group has any non-zero digits
-		hasNonZero := false
-		for _, digit := range group {
-			if digit != 0 {
-				hasNonZero = true
-				break
-			}
+
+	// Zeros in [zeroStart, zeroEnd) are voiced when VerboseZeros is enabled
+	zeroStart, zeroEnd := 0, 0
+	if opts.verboseZeros {
+		zeroStart, zeroEnd = interiorZeroRange(digits)
+	}
+
+	lex := opts.words()
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	if digitCount <= 6 {
+		writeSixDigitGroup(buf, lex, digits, zeroStart, zeroEnd)
+		return buf.String()
+	}
+
+	millionWord := lex.Unit(6)
+
+	// A number reads as its digits above the last six, then "ล้าน", then the
+	// last six digits, applied recursively. Unrolled, every group boundary
+	// gets exactly one "ล้าน" once a non-zero digit sits to its left, so a
+	// number whose leading non-zero group is k groups from the right reads
+	// exactly k "ล้าน", however its other groups are filled:
+	//
+	//	1,000,000,000,000 -> หนึ่ง|ล้าน|ล้าน       (หนึ่งล้านล้าน)
+	//	1,000,000,000,001 -> หนึ่ง|ล้าน|ล้าน|เอ็ด  (หนึ่งล้านล้านเอ็ด)
+	//	1,000,001,000,000 -> หนึ่ง|ล้าน|เอ็ด|ล้าน  (หนึ่งล้านเอ็ดล้าน)
+	//
+	// Process in groups of 6 digits from left to right; the leftmost group
+	// holds the remainder when the digit count is not a multiple of six
+	startPos := 0
+	seenNonZero := false
+	afterMillion := false // the last word written is "ล้าน"
+	for groupsFromRight := (digitCount+5)/6 - 1; groupsFromRight >= 0; groupsFromRight-- {
+		// Stop early when canceled; the caller reports ctx.Err()
+		if opts.ctx != nil && opts.ctx.Err() != nil {
+			return ""
 		}
 
-		if hasNonZero {
-			count++
+		endPos := digitCount - groupsFromRight*6
+		group := digits[startPos:endPos]
+		before := buf.Len()
+		writeSixDigitGroup(buf, lex, group, max(zeroStart-startPos, 0), min(zeroEnd, endPos)-startPos)
+		startPos = endPos
+
+		if buf.Len() != before {
+			afterMillion = false
+		}
+		seenNonZero = seenNonZero || hasNonZero(group)
+		if groupsFromRight == 0 || !seenNonZero {
+			continue
 		}
+
+		if afterMillion {
+			buf.WriteString(opts.millionRepeatSeparator)
+		}
+		if opts.spaceBeforeMillion {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(millionWord)
+		afterMillion = true
 	}
 
-	return count
+	return buf.String()
 }
 
-func buildThaiText(digits []int) string {
-	digitCount := len(digits)
-	if digitCount <= 6 {
-		return convertSixDigitGroup(digits)
-	}
-
-	// Pre-allocate slice with estimated capacity
-	groupCount := (digitCount + 5) / 6
-	result := make([]string, 0, groupCount)
-
-	// Process in groups of 6 digits from right to left
-	groupsFromRight := 0
-	for startPos := digitCount; startPos > 0; startPos -= 6 {
-		endPos := max(startPos-6, 0)
-		group := digits[endPos:startPos]
-		groupText := convertSixDigitGroup(group)
-
-		if groupText != "" {
-			// Add "ล้าน" suffix based on pattern:
-			// - For numbers where most groups are zeros (like 1,000,000,000,000):
-			//   the non-zero group gets multiple ล้าน based on total groups
-			// - For numbers with digits in multiple groups:
-			//   each group gets single ล้าน except rightmost
-
-			// Check if this is a "telescoping zeros" pattern by counting non-zero groups
-			hasMultipleNonZeroGroups := countNonZeroGroups(digits)
-
-			if hasMultipleNonZeroGroups > 1 {
-				// Multiple groups have non-zero digits: use single ล้าน rule
-				if groupsFromRight > 0 {
-					groupText += "ล้าน"
-				}
-			} else {
-				// Only one group has non-zero digits: use multiple ล้าน rule
-				// Use strings.Builder for efficient concatenation
-				var builder strings.Builder
-				builder.WriteString(groupText)
-				for i := 0; i < groupsFromRight; i++ {
-					builder.WriteString("ล้าน")
-				}
-				groupText = builder.String()
+// interiorZeroRange returns the index range between the first and last
+// non-zero digits, where zeros sit inside the number rather than trailing it
+func interiorZeroRange(digits []int) (int, int) {
+	first, last := -1, -1
+	for i, digit := range digits {
+		if digit != 0 {
+			if first == -1 {
+				first = i
 			}
-
-			result = append([]string{groupText}, result...)
+			last = i
 		}
-		groupsFromRight++
 	}
-
-	return strings.Join(result, "")
+	if first == -1 {
+		return 0, 0
+	}
+	return first + 1, last
 }
 
-func convertSixDigitGroup(digits []int) string {
+// writeSixDigitGroup writes the reading of up to six digits to buf. Zero
+// digits at positions in [zeroStart, zeroEnd) are voiced as "ศูนย์" plus their
+// unit instead of skipped, except in the ones place: that zero only falls in
+// the range before "ล้าน", where it has no unit to voice.
+func writeSixDigitGroup(buf *bytes.Buffer, lex Lexicon, digits []int, zeroStart, zeroEnd int) {
 	digitCount := len(digits)
-	// Pre-allocate slice with maximum possible capacity (6 digits)
-	result := make([]string, 0, digitCount)
 
 	for position, digit := range digits {
+		positionFromRight := digitCount - position - 1
+		unitIndex := positionFromRight % 6
+
 		if digit == 0 {
+			if position >= zeroStart && position < zeroEnd && unitIndex != 0 {
+				buf.WriteString(lex.Digit(0))
+				buf.WriteString(lex.Unit(unitIndex))
+			}
 			continue
 		}
 
-		positionFromRight := digitCount - position - 1
-		unitIndex := positionFromRight % 6
-
-		text := convertDigitAtPosition(digit, unitIndex, positionFromRight, len(digits))
-		if text != "" {
-			result = append(result, text)
+		// Hundreds and up are written in two parts to skip concatenating
+		if unitIndex >= 2 {
+			buf.WriteString(lex.Digit(digit))
+			buf.WriteString(lex.Unit(unitIndex))
+			continue
 		}
+		buf.WriteString(convertDigitAtPosition(lex, digit, unitIndex, positionFromRight, digitCount))
 	}
-
-	return strings.Join(result, "")
 }
 
-func convertDigitAtPosition(digit, unitIndex, positionFromRight, totalDigits int) string {
-	digitName := digitNames[digit]
-	unitName := unitNames[unitIndex]
-
+func convertDigitAtPosition(lex Lexicon, digit, unitIndex, positionFromRight, totalDigits int) string {
 	switch unitIndex {
 	case 0: // ones place
 		if digit == 1 && totalDigits > 1 && positionFromRight == 0 {
-			return "เอ็ด" + unitName
+			return lex.TrailingOne()
 		}
-		return digitName + unitName
+		return lex.Digit(digit)
 
 	case 1: // tens place
-		switch digit {
-		case 1:
-			return unitName
-		case 2:
-			return "ยี่" + unitName
-		default:
-			return digitName + unitName
-		}
+		return lex.Tens(digit)
 
 	default: // hundreds, thousands, etc.
-		return digitName + unitName
+		return lex.Digit(digit) + lex.Unit(unitIndex)
 	}
 }
 
+// convertDecimalPart reads two satang digits in Thai
 func convertDecimalPart(decimalStr string) string {
+	return readSatang(decimalStr, ThaiLexicon)
+}
+
+// readSatang reads two satang digits with lex. A lone 1 reads as "หนึ่ง"
+// (01 -> หนึ่งสตางค์) and only a 1 after the tens reads as "เอ็ด".
+func readSatang(decimalStr string, lex Lexicon) string {
 	if !isValidNumber(decimalStr) {
 		return ""
 	}
 
-	value, _ := strconv.Atoi(decimalStr)
+	// Other minor unit digit counts read like a short integer
+	if len(decimalStr) != 2 {
+		digits := parseDigits(strings.TrimLeft(decimalStr, "0"))
+		buf := getBuffer()
+		defer putBuffer(buf)
+		writeSixDigitGroup(buf, lex, digits, 0, 0)
+		return buf.String()
+	}
 
-	// Special cases for decimal satang conversion
+	// Two digits are read on their own: a 1 after the tens is "เอ็ด" and a
+	// lone 1 is "หนึ่ง", so 01 never borrows the integer grouping rules
+	tens, ones := int(decimalStr[0]-'0'), int(decimalStr[1]-'0')
+
+	text := ""
+	if tens > 0 {
+		text = lex.Tens(tens)
+	}
 	switch {
-	case value == 1:
-		return "หนึ่ง" // 01 -> หนึ่งสตางค์
-	case value == 11:
-		return "สิบเอ็ด" // 11 -> สิบเอ็ดสตางค์
-	case value >= 12 && value <= 19:
-		// 12-19: regular conversion (สิบสอง, สิบสาม, etc.)
-		ones := value - 10
-		return "สิบ" + digitNames[ones]
-	case value >= 21 && value <= 99 && value%10 == 1:
-		// 21, 31, 41, etc.: use เอ็ด for ones place
-		tens := value / 10
-		if tens == 2 {
-			return "ยี่สิบเอ็ด"
-		}
-		return digitNames[tens] + "สิบเอ็ด"
-	default:
-		// For all other cases, use regular conversion
-		return convertIntegerNumber(decimalStr)
+	case ones == 1 && tens > 0:
+		text += lex.TrailingOne()
+	case ones > 0:
+		text += lex.Digit(ones)
 	}
+	return text
 }
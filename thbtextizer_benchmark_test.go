@@ -1,6 +1,8 @@
 package thbtextizer
 
 import (
+	"fmt"
+	"io"
 	"testing"
 )
 
@@ -8,9 +10,10 @@ import (
 func BenchmarkConvert(b *testing.B) {
 	testCases := []struct {
 		name   string
-		amount string
+		amount any
 	}{
 		{"small_numbers", "123.45"},
+		{"small_int", 1234},
 		{"medium_numbers", "12345.67"},
 		{"large_numbers", "123456789.99"},
 		{"very_large_numbers", "9223372036854775807"},
@@ -162,6 +165,28 @@ func BenchmarkConcurrentUsage(b *testing.B) {
 			}
 		})
 	})
+
+	// Converters with opposite settings must not observe each other's config
+	b.Run("opposite_overflow_converters", func(b *testing.B) {
+		capped := NewConverter(&Config{AllowOverflow: false})
+		overflowing := NewConverter(&Config{AllowOverflow: true})
+
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				converter, expected := capped, "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์"
+				if i%2 == 1 {
+					converter, expected = overflowing, "หนึ่งร้อยเอ็ดบาทถ้วน"
+				}
+				result, err := converter.Convert("100.995")
+				if err != nil {
+					b.Fatal(err)
+				}
+				if result != expected {
+					b.Fatalf("Convert(100.995) = %s, expected %s", result, expected)
+				}
+			}
+		})
+	})
 }
 
 // BenchmarkInputTypes tests performance with different input types
@@ -215,3 +240,83 @@ func BenchmarkInputSanitization(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkConvertTo compares streaming to a writer against building a string first
+func BenchmarkConvertTo(b *testing.B) {
+	b.Run("convert_then_write", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			result, err := Convert("123456.78")
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := io.WriteString(io.Discard, result); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("convert_to", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := ConvertTo(io.Discard, "123456.78"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkConvertAll compares batch conversion against converting one by one
+func BenchmarkConvertAll(b *testing.B) {
+	amounts := make([]any, 100)
+	for i := range amounts {
+		amounts[i] = float64(i) * 1234.56
+	}
+
+	b.Run("convert_loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, amount := range amounts {
+				if _, err := Convert(amount); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("convert_all", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ConvertAll(amounts)
+		}
+	})
+}
+
+// BenchmarkCachingConverter compares cached and uncached conversion on a
+// ledger-like workload where a few amounts recur many times
+func BenchmarkCachingConverter(b *testing.B) {
+	amounts := make([]string, 64)
+	for i := range amounts {
+		amounts[i] = fmt.Sprintf("%d%03d.%02d", i%16+1, i*37%1000, i%100)
+	}
+
+	converters := []struct {
+		name      string
+		converter *Converter
+	}{
+		{"uncached", NewDefaultConverter()},
+		{"cached", NewCachingConverter(nil, 128)},
+	}
+
+	for _, tc := range converters {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := tc.converter.Convert(amounts[i%len(amounts)])
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,161 +1,180 @@
 package thbtextizer
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 )
 
-func TestConvert(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected string
-	}{
-		{
-			input:    "147521.19",
-			expected: "หนึ่งแสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทสิบเก้าสตางค์",
-		},
-		{
-			input:    "147521",
-			expected: "หนึ่งแสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "147521.00",
-			expected: "หนึ่งแสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "0",
-			expected: "ศูนย์บาทถ้วน",
-		},
-		{
-			input:    "0.50",
-			expected: "ศูนย์บาทห้าสิบสตางค์",
-		},
-		{
-			input:    "1000000",
-			expected: "หนึ่งล้านบาทถ้วน",
-		},
-		{
-			input:    "1000000.25",
-			expected: "หนึ่งล้านบาทยี่สิบห้าสตางค์",
-		},
-		{
-			input:    "100.01",
-			expected: "หนึ่งร้อยบาทหนึ่งสตางค์",
-		},
-		{
-			input:    "50.05",
-			expected: "ห้าสิบบาทห้าสตางค์",
-		},
-		{
-			input:    "11",
-			expected: "สิบเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "21",
-			expected: "ยี่สิบเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "31",
-			expected: "สามสิบเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "91",
-			expected: "เก้าสิบเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "1",
-			expected: "หนึ่งบาทถ้วน",
-		},
-		{
-			input:    "101",
-			expected: "หนึ่งร้อยเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "100.11",
-			expected: "หนึ่งร้อยบาทสิบเอ็ดสตางค์",
-		},
-		{
-			input:    "111",
-			expected: "หนึ่งร้อยสิบเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "1001",
-			expected: "หนึ่งพันเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "2501",
-			expected: "สองพันห้าร้อยเอ็ดบาทถ้วน",
-		},
-		{
-			input:    "100000001.01",
-			expected: "หนึ่งร้อยล้านเอ็ดบาทหนึ่งสตางค์",
-		},
-		{
-			input:    "100.21",
-			expected: "หนึ่งร้อยบาทยี่สิบเอ็ดสตางค์",
-		},
-		{
-			input:    "100.31",
-			expected: "หนึ่งร้อยบาทสามสิบเอ็ดสตางค์",
-		},
-		{
-			input:    "0",
-			expected: "ศูนย์บาทถ้วน",
-		},
-		{
-			input:    "21.25",
-			expected: "ยี่สิบเอ็ดบาทยี่สิบห้าสตางค์",
-		},
-		{
-			input:    "1234567.89",
-			expected: "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทแปดสิบเก้าสตางค์",
-		},
-		{
-			input:    "500200300.00",
-			expected: "ห้าร้อยล้านสองแสนสามร้อยบาทถ้วน",
-		},
-		{
-			input:    "999999999.99",
-			expected: "เก้าร้อยเก้าสิบเก้าล้านเก้าแสนเก้าหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าบาทเก้าสิบเก้าสตางค์",
-		},
-		{
-			input:    "1,234,567,889,999,999,999",
-			expected: "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดล้านแปดแสนแปดหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าล้านเก้าแสนเก้าหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าบาทถ้วน",
-		},
-		{
-			input:    "9,223,372,036,854,775,807",
-			expected: "เก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยเจ็ดบาทถ้วน",
-		},
-		{
-			input:    "1,000,000,000,000,000,000",
-			expected: "หนึ่งล้านล้านล้านบาทถ้วน",
-		},
-		{
-			input:    "100,000,000,000,000,000",
-			expected: "หนึ่งแสนล้านล้านบาทถ้วน",
-		},
-		{
-			input:    "10,000,000,000,000,000",
-			expected: "หนึ่งหมื่นล้านล้านบาทถ้วน",
-		},
-		{
-			input:    "1,000,000,000,000,000",
-			expected: "หนึ่งพันล้านล้านบาทถ้วน",
-		},
-		{
-			input:    "100,000,000,000,000",
-			expected: "หนึ่งร้อยล้านล้านบาทถ้วน",
-		},
-		{
-			input:    "10,000,000,000,000",
-			expected: "สิบล้านล้านบาทถ้วน",
-		},
-		{
-			input:    "1,000,000,000,000",
-			expected: "หนึ่งล้านล้านบาทถ้วน",
-		},
-	}
+// convertTestCases is the core reading table, shared with the Parse round-trip test
+var convertTestCases = []struct {
+	input    string
+	expected string
+}{
+	{
+		input:    "147521.19",
+		expected: "หนึ่งแสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทสิบเก้าสตางค์",
+	},
+	{
+		input:    "147521",
+		expected: "หนึ่งแสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "147521.00",
+		expected: "หนึ่งแสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "0",
+		expected: "ศูนย์บาทถ้วน",
+	},
+	{
+		input:    "0.50",
+		expected: "ศูนย์บาทห้าสิบสตางค์",
+	},
+	{
+		input:    "1000000",
+		expected: "หนึ่งล้านบาทถ้วน",
+	},
+	{
+		input:    "1000000.25",
+		expected: "หนึ่งล้านบาทยี่สิบห้าสตางค์",
+	},
+	{
+		input:    "100.01",
+		expected: "หนึ่งร้อยบาทหนึ่งสตางค์",
+	},
+	{
+		input:    "50.05",
+		expected: "ห้าสิบบาทห้าสตางค์",
+	},
+	{
+		input:    "11",
+		expected: "สิบเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "21",
+		expected: "ยี่สิบเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "31",
+		expected: "สามสิบเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "91",
+		expected: "เก้าสิบเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "1",
+		expected: "หนึ่งบาทถ้วน",
+	},
+	{
+		input:    "101",
+		expected: "หนึ่งร้อยเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "100.11",
+		expected: "หนึ่งร้อยบาทสิบเอ็ดสตางค์",
+	},
+	{
+		input:    "111",
+		expected: "หนึ่งร้อยสิบเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "1001",
+		expected: "หนึ่งพันเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "2501",
+		expected: "สองพันห้าร้อยเอ็ดบาทถ้วน",
+	},
+	{
+		input:    "100000001.01",
+		expected: "หนึ่งร้อยล้านเอ็ดบาทหนึ่งสตางค์",
+	},
+	{
+		input:    "100.21",
+		expected: "หนึ่งร้อยบาทยี่สิบเอ็ดสตางค์",
+	},
+	{
+		input:    "100.31",
+		expected: "หนึ่งร้อยบาทสามสิบเอ็ดสตางค์",
+	},
+	{
+		input:    "0",
+		expected: "ศูนย์บาทถ้วน",
+	},
+	{
+		input:    "21.25",
+		expected: "ยี่สิบเอ็ดบาทยี่สิบห้าสตางค์",
+	},
+	{
+		input:    "1234567.89",
+		expected: "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทแปดสิบเก้าสตางค์",
+	},
+	{
+		input:    "500200300.00",
+		expected: "ห้าร้อยล้านสองแสนสามร้อยบาทถ้วน",
+	},
+	{
+		input:    "999999999.99",
+		expected: "เก้าร้อยเก้าสิบเก้าล้านเก้าแสนเก้าหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าบาทเก้าสิบเก้าสตางค์",
+	},
+	{
+		input:    "1,234,567,889,999,999,999",
+		expected: "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดล้านแปดแสนแปดหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าล้านเก้าแสนเก้าหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าบาทถ้วน",
+	},
+	{
+		input:    "9,223,372,036,854,775,807",
+		expected: "เก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยเจ็ดบาทถ้วน",
+	},
+	{
+		input:    "1,000,000,000,000,000,000",
+		expected: "หนึ่งล้านล้านล้านบาทถ้วน",
+	},
+	{
+		input:    "100,000,000,000,000,000",
+		expected: "หนึ่งแสนล้านล้านบาทถ้วน",
+	},
+	{
+		input:    "10,000,000,000,000,000",
+		expected: "หนึ่งหมื่นล้านล้านบาทถ้วน",
+	},
+	{
+		input:    "1,000,000,000,000,000",
+		expected: "หนึ่งพันล้านล้านบาทถ้วน",
+	},
+	{
+		input:    "100,000,000,000,000",
+		expected: "หนึ่งร้อยล้านล้านบาทถ้วน",
+	},
+	{
+		input:    "10,000,000,000,000",
+		expected: "สิบล้านล้านบาทถ้วน",
+	},
+	{
+		input:    "1,000,000,000,000",
+		expected: "หนึ่งล้านล้านบาทถ้วน",
+	},
+	{
+		input:    "1,000,000,000,001.25",
+		expected: "หนึ่งล้านล้านเอ็ดบาทยี่สิบห้าสตางค์",
+	},
+	{
+		input:    "2,000,001,000,000",
+		expected: "สองล้านเอ็ดล้านบาทถ้วน",
+	},
+}
 
-	for _, test := range tests {
+func TestConvert(t *testing.T) {
+	for _, test := range convertTestCases {
 		result, err := Convert(test.input)
 		if err != nil {
 			t.Errorf("Convert(%s) returned error: %v", test.input, err)
@@ -167,6 +186,30 @@ func TestConvert(t *testing.T) {
 	}
 }
 
+func TestPowersOfTen(t *testing.T) {
+	// A leading 1 always reads หนึ่ง except in the tens place, where Thai
+	// says สิบ rather than หนึ่งสิบ
+	places := []string{"หนึ่ง", "สิบ", "หนึ่งร้อย", "หนึ่งพัน", "หนึ่งหมื่น", "หนึ่งแสน"}
+
+	for exponent := 0; exponent <= 18; exponent++ {
+		input := "1" + strings.Repeat("0", exponent)
+		expected := places[exponent%6] + strings.Repeat("ล้าน", exponent/6) + "บาทถ้วน"
+
+		t.Run(input, func(t *testing.T) {
+			result, err := Convert(input)
+			if err != nil {
+				t.Fatalf("Convert(%s) returned error: %v", input, err)
+			}
+			if result != expected {
+				t.Errorf("Convert(%s) = %s, expected %s", input, result, expected)
+			}
+			if exponent%6 != 1 && !strings.HasPrefix(result, "หนึ่ง") {
+				t.Errorf("Convert(%s) = %s, expected a leading หนึ่ง", input, result)
+			}
+		})
+	}
+}
+
 func TestConvertWithRounding(t *testing.T) {
 	tests := []struct {
 		input        string
@@ -290,6 +333,11 @@ func TestConvertWithNumericTypes(t *testing.T) {
 		{input: float64(100.5), expected: "หนึ่งร้อยบาทห้าสิบสตางค์", name: "float64 with .5"},
 		{input: float64(50), expected: "ห้าสิบบาทถ้วน", name: "float64 whole number"},
 
+		// Decoded JSON numbers
+		{input: json.Number("12345.67"), expected: "หนึ่งหมื่นสองพันสามร้อยสี่สิบห้าบาทหกสิบเจ็ดสตางค์", name: "json.Number"},
+		{input: json.Number("-20"), expected: "ลบยี่สิบบาทถ้วน", name: "negative json.Number"},
+		{input: json.Number("12345678901234567.89"), expected: "หนึ่งหมื่นสองพันสามร้อยสี่สิบห้าล้านหกแสนเจ็ดหมื่นแปดพันเก้าร้อยเอ็ดล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทแปดสิบเก้าสตางค์", name: "json.Number beyond float64 precision"},
+
 		// Edge cases
 		{input: 0, expected: "ศูนย์บาทถ้วน", name: "zero int"},
 		{input: float64(0.0), expected: "ศูนย์บาทถ้วน", name: "zero float"},
@@ -402,6 +450,12 @@ func TestConvertWithOverflowHandling(t *testing.T) {
 		{input: "100.994", roundingMode: RoundHalf, allowOverflow: false, expected: "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์", name: "0.994 with RoundHalf (no overflow needed)"},
 		{input: "100.994", roundingMode: RoundHalf, allowOverflow: true, expected: "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์", name: "0.994 with RoundHalf and overflow (no overflow needed)"},
 		{input: "100.991", roundingMode: RoundUp, allowOverflow: false, expected: "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์", name: "0.991 with RoundUp (normal)"},
+
+		// Overflow on integer parts near the int64 limit carries without strconv
+		{input: "9223372036854775806.995", roundingMode: RoundHalf, allowOverflow: true, expected: "เก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยเจ็ดบาทถ้วน", name: "19-digit integer with RoundHalf and overflow"},
+		{input: "9223372036854775806.991", roundingMode: RoundUp, allowOverflow: true, expected: "เก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยเจ็ดบาทถ้วน", name: "19-digit integer with RoundUp and overflow"},
+		{input: "2147483647.995", roundingMode: RoundHalf, allowOverflow: true, expected: "สองพันหนึ่งร้อยสี่สิบเจ็ดล้านสี่แสนแปดหมื่นสามพันหกร้อยสี่สิบแปดบาทถ้วน", name: "int32 limit with RoundHalf and overflow"},
+		{input: "9223372036854775806.995", roundingMode: RoundHalf, allowOverflow: false, expected: "เก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยหกบาทเก้าสิบเก้าสตางค์", name: "19-digit integer with RoundHalf (forced down)"},
 	}
 
 	for _, test := range tests {
@@ -461,6 +515,67 @@ func TestWarningLogControl(t *testing.T) {
 	}
 }
 
+func TestWarningLogger(t *testing.T) {
+	var records bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&records, nil))
+	converter := NewConverter(&Config{Logger: logger})
+
+	result, err := converter.Convert("100.995")
+	if err != nil {
+		t.Fatalf("Convert(100.995) with Logger returned error: %v", err)
+	}
+	if expected := "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์"; result != expected {
+		t.Errorf("Convert(100.995) with Logger = %s, expected %s", result, expected)
+	}
+
+	record := records.String()
+	for _, field := range []string{"level=WARN", "decimal=995", "capped=99"} {
+		if !strings.Contains(record, field) {
+			t.Errorf("Logger record %q does not contain %s", record, field)
+		}
+	}
+
+	// Amounts that do not need capping log nothing
+	records.Reset()
+	if _, err := converter.Convert("100.994"); err != nil || records.Len() != 0 {
+		t.Errorf("Convert(100.994) with Logger logged %q, %v", records.String(), err)
+	}
+
+	// The logger's level decides what is kept
+	quiet := slog.New(slog.NewTextHandler(&records, &slog.HandlerOptions{Level: slog.LevelError}))
+	if _, err := NewConverter(&Config{Logger: quiet}).Convert("100.995"); err != nil || records.Len() != 0 {
+		t.Errorf("Convert(100.995) with an error-level Logger logged %q, %v", records.String(), err)
+	}
+}
+
+func TestOnRound(t *testing.T) {
+	var calls [][2]string
+	converter := NewConverter(&Config{
+		AllowOverflow: true,
+		OnRound: func(original, rounded string) {
+			calls = append(calls, [2]string{original, rounded})
+		},
+	})
+
+	inputs := []string{"0.456", "0.45", "0.4500", "1,234.001", "-7.125", "100.995", "12", "-0.001"}
+	for _, input := range inputs {
+		if _, err := converter.Convert(input); err != nil {
+			t.Errorf("Convert(%s) with OnRound returned error: %v", input, err)
+		}
+	}
+
+	expected := [][2]string{
+		{"0.456", "0.46"},
+		{"1234.001", "1234.00"},
+		{"-7.125", "-7.13"},
+		{"100.995", "101.00"},
+		{"-0.001", "0.00"},
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("OnRound calls = %v, expected %v", calls, expected)
+	}
+}
+
 func TestProblematicLargeNumbers(t *testing.T) {
 	// Test the specific large numbers mentioned in the issue
 	testCases := []struct {
@@ -562,12 +677,13 @@ func TestInputSanitization(t *testing.T) {
 		{"1_234.56", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์", false, "underscore removal"},
 		{"1,234.56", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์", false, "comma handling"},
 		{"+123.45", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์", false, "positive sign removal"},
-		{"-123.45", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์", false, "negative sign removal"},
+		{"-123.45", "ลบหนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์", false, "negative sign"},
 		{".45", "ศูนย์บาทสี่สิบห้าสตางค์", false, "leading decimal"},
 		{"123.", "หนึ่งร้อยยี่สิบสามบาทถ้วน", false, "trailing decimal"},
 		{"", "", true, "empty input"},
 		{"12.34.56", "", true, "multiple decimals"},
 		{"abc", "", true, "invalid characters"},
+		{"1,234.5,0", "", true, "comma after decimal point"},
 	}
 
 	for _, test := range tests {
@@ -621,6 +737,302 @@ func TestErrorTypes(t *testing.T) {
 	}
 }
 
+func TestErrorSentinels(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, canceledErr := ConvertContext(canceled, "1")
+	_, roundingErr := NewConverter(&Config{ErrorOnRounding: true}).Convert("1.005")
+	_, parseErr := Parse("ไม่ใช่จำนวนเงิน")
+	_, unsupportedErr := Convert([]int{1})
+	_, maxErr := Convert("100000000000000000000")
+	_, invalidErr := Convert("abc")
+
+	tests := []struct {
+		err      error
+		sentinel error
+	}{
+		{unsupportedErr, ErrUnsupportedType},
+		{maxErr, ErrExceedsMaxValue},
+		{invalidErr, ErrInvalidInput},
+		{parseErr, ErrParseError},
+		{roundingErr, ErrRoundingOccurred},
+		{canceledErr, ErrCanceled},
+	}
+
+	for i, test := range tests {
+		if !errors.Is(test.err, test.sentinel) {
+			t.Errorf("errors.Is(%v, %v) = false, expected true", test.err, test.sentinel)
+		}
+		for j, other := range tests {
+			if i != j && errors.Is(test.err, other.sentinel) {
+				t.Errorf("errors.Is(%v, %v) = true, expected false", test.err, other.sentinel)
+			}
+		}
+	}
+
+	// Wrapped causes still match alongside the sentinel
+	if !errors.Is(canceledErr, context.Canceled) {
+		t.Errorf("errors.Is(%v, context.Canceled) = false, expected true", canceledErr)
+	}
+}
+
+func TestConvertWithValue(t *testing.T) {
+	overflow := NewConverter(&Config{AllowOverflow: true})
+	capped := NewConverter(&Config{DefaultRounding: RoundHalf}) // without warning logs
+
+	tests := []struct {
+		converter *Converter
+		input     any
+		text      string
+		value     string
+	}{
+		{capped, "123.45", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์", "123.45"},
+		{capped, "1,234", "หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน", "1234.00"},
+		{capped, "0.5", "ศูนย์บาทห้าสิบสตางค์", "0.50"},
+		{capped, "-7.125", "ลบเจ็ดบาทสิบสามสตางค์", "-7.13"},
+		{capped, "100.995", "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์", "100.99"},
+		{overflow, "100.995", "หนึ่งร้อยเอ็ดบาทถ้วน", "101.00"},
+		{overflow, "-0.001", "ศูนย์บาทถ้วน", "0.00"},
+	}
+
+	for _, test := range tests {
+		text, value, err := test.converter.ConvertWithValue(test.input)
+		if err != nil {
+			t.Errorf("ConvertWithValue(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if text != test.text || value != test.value {
+			t.Errorf("ConvertWithValue(%v) = %s, %s, expected %s, %s", test.input, text, value, test.text, test.value)
+		}
+	}
+
+	if text, value, err := ConvertWithValue("1.234", RoundUp); err != nil || text != "หนึ่งบาทยี่สิบสี่สตางค์" || value != "1.24" {
+		t.Errorf("ConvertWithValue(1.234, RoundUp) = %s, %s, %v", text, value, err)
+	}
+
+	if _, _, err := ConvertWithValue("abc"); err == nil {
+		t.Error("ConvertWithValue(abc) expected error, got nil")
+	}
+}
+
+func TestConvertDetailed(t *testing.T) {
+	SetAllowOverflow(true)
+	defer SetAllowOverflow(false)
+
+	tests := []struct {
+		input      any
+		mode       DecimalRoundingMode
+		text       string
+		normalized string
+	}{
+		{"100.995", RoundHalf, "หนึ่งร้อยเอ็ดบาทถ้วน", "101.00"},
+		{"1,234.5", RoundHalf, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์", "1234.50"},
+		{"  -0.125 ", RoundDown, "ลบศูนย์บาทสิบสองสตางค์", "-0.12"},
+		{"-0.001", RoundHalf, "ศูนย์บาทถ้วน", "0.00"},
+		{42, RoundHalf, "สี่สิบสองบาทถ้วน", "42.00"},
+	}
+
+	for _, test := range tests {
+		detail, err := ConvertDetailed(test.input, test.mode)
+		if err != nil {
+			t.Errorf("ConvertDetailed(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if detail.Text != test.text || detail.Value != test.normalized {
+			t.Errorf("ConvertDetailed(%v) = %s, %s, expected %s, %s", test.input, detail.Text, detail.Value, test.text, test.normalized)
+		}
+		if detail.Capped || len(detail.Warnings) != 0 {
+			t.Errorf("ConvertDetailed(%v) = %+v, expected no capping or warnings", test.input, detail)
+		}
+		if detail.Mode != test.mode {
+			t.Errorf("ConvertDetailed(%v) mode = %v, expected %v", test.input, detail.Mode, test.mode)
+		}
+		if converted, _ := Convert(test.input, test.mode); converted != detail.Text {
+			t.Errorf("ConvertDetailed(%v) text %s differs from Convert %s", test.input, detail.Text, converted)
+		}
+	}
+
+	converter := NewConverter(&Config{DefaultRounding: RoundUp})
+	if detail, err := converter.ConvertDetailed("1.231"); err != nil || detail.Value != "1.24" || detail.Text != "หนึ่งบาทยี่สิบสี่สตางค์" || detail.Mode != RoundUp {
+		t.Errorf("ConvertDetailed(1.231) with RoundUp default = %+v, %v", detail, err)
+	}
+	if detail, _ := converter.ConvertDetailed("1.231", RoundDown); detail.Mode != RoundDown {
+		t.Errorf("ConvertDetailed(1.231, RoundDown) mode = %v, expected RoundDown", detail.Mode)
+	}
+	if detail, _ := ConvertDetailed("1.231"); detail.Mode != RoundHalf {
+		t.Errorf("ConvertDetailed(1.231) mode = %v, expected RoundHalf", detail.Mode)
+	}
+
+	if _, err := ConvertDetailed("1.2.3"); err == nil {
+		t.Error("ConvertDetailed(1.2.3) expected error, got nil")
+	}
+}
+
+func TestConvertDetailedCapped(t *testing.T) {
+	converter := NewConverter(&Config{})
+
+	for _, input := range []string{"100.995", "-0.999"} {
+		detail, err := converter.ConvertDetailed(input)
+		if err != nil {
+			t.Errorf("ConvertDetailed(%s) returned error: %v", input, err)
+			continue
+		}
+		if !detail.Capped || len(detail.Warnings) != 1 || !errors.Is(detail.Warnings[0], ErrSatangCapped) {
+			t.Errorf("ConvertDetailed(%s) = %+v, expected a satang capped warning", input, detail)
+			continue
+		}
+		if !strings.Contains(detail.Warnings[0].Error(), input) {
+			t.Errorf("ConvertDetailed(%s) warning %v does not name the input", input, detail.Warnings[0])
+		}
+	}
+
+	// Rounding that stays within satang and overflow that carries are not capping
+	overflow := NewConverter(&Config{AllowOverflow: true})
+	for _, test := range []struct {
+		converter *Converter
+		input     string
+	}{{converter, "100.994"}, {converter, "100.985"}, {overflow, "100.995"}} {
+		if detail, _ := test.converter.ConvertDetailed(test.input); detail.Capped || detail.Warnings != nil {
+			t.Errorf("ConvertDetailed(%s) = %+v, expected no capping", test.input, detail)
+		}
+	}
+
+	thai := NewConverter(&Config{ErrorLanguage: ErrorLanguageThai})
+	if detail, _ := thai.ConvertDetailed("1.999"); len(detail.Warnings) != 1 || !strings.Contains(detail.Warnings[0].Error(), "สตางค์ถูกจำกัด") {
+		t.Errorf("ConvertDetailed(1.999) with Thai errors = %+v, expected a Thai warning", detail)
+	}
+}
+
+func TestDigitAndUnitWords(t *testing.T) {
+	digits := [10]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+	units := [7]string{"", "X", "C", "M", "W", "L", "Mil"}
+
+	tests := []struct {
+		config   Config
+		input    string
+		expected string
+	}{
+		{Config{DigitWords: digits}, "123.45", "oneร้อยยี่สิบthreeบาทfourสิบfiveสตางค์"},
+		{Config{UnitWords: units}, "2,000,021", "สองMilยี่Xเอ็ดบาทถ้วน"},
+		{Config{DigitWords: digits, UnitWords: units}, "110.01", "oneCXบาทoneสตางค์"},
+		{Config{DigitWords: digits, SatangAsDecimal: true}, "0.05", "zeroบาทจุดzerofive"},
+		{Config{DigitWords: digits, ZeroSatangStyle: ExplicitZeroSatang}, "7", "sevenบาทzeroสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := NewConverter(&test.config).Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with custom words = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	tokens, err := NewConverter(&Config{DigitWords: digits, UnitWords: units}).ConvertTokens("21")
+	if expected := []string{"ยี่", "X", "เอ็ด", "บาท", "ถ้วน"}; err != nil || !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("ConvertTokens(21) with custom words = %v, %v, expected %v", tokens, err, expected)
+	}
+
+	partialDigits := digits
+	partialDigits[3] = ""
+	partialUnits := [7]string{1: "X"}
+	for _, config := range []*Config{{DigitWords: partialDigits}, {UnitWords: partialUnits}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewConverter with %+v expected panic", *config)
+				}
+			}()
+			NewConverter(config)
+		}()
+	}
+}
+
+func TestMustConvert(t *testing.T) {
+	if result := MustConvert("123.45"); result != "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์" {
+		t.Errorf("MustConvert(123.45) = %s", result)
+	}
+	if result := NewConverter(&Config{DefaultRounding: RoundUp}).MustConvert("1.231"); result != "หนึ่งบาทยี่สิบสี่สตางค์" {
+		t.Errorf("MustConvert(1.231) with RoundUp default = %s", result)
+	}
+
+	for _, convert := range []func(any, ...DecimalRoundingMode) string{MustConvert, NewDefaultConverter().MustConvert} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "abc") {
+					t.Errorf("MustConvert(abc) panicked with %v, expected a message naming the input", r)
+				}
+			}()
+			convert("abc")
+		}()
+	}
+}
+
+func TestLeadingZeros(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"007", "เจ็ดบาทถ้วน"},
+		{"001", "หนึ่งบาทถ้วน"},
+		{"011", "สิบเอ็ดบาทถ้วน"},
+		{"000.50", "ศูนย์บาทห้าสิบสตางค์"},
+		{"000", "ศูนย์บาทถ้วน"},
+		{"-0001.01", "ลบหนึ่งบาทหนึ่งสตางค์"},
+		{"0001000001", "หนึ่งล้านเอ็ดบาทถ้วน"},
+		{"0,000,000,000,021", "ยี่สิบเอ็ดบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestOverflowCarryOnLargeNumbers(t *testing.T) {
+	converter := NewConverter(&Config{AllowOverflow: true})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"9999999999.999", "หนึ่งหมื่นล้านบาทถ้วน"},
+		{"0999.995", "หนึ่งพันบาทถ้วน"},
+		{"9223372036854775806.999", "เก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยเจ็ดบาทถ้วน"},
+		{"-999999999999999999.995", "ลบหนึ่งล้านล้านล้านบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) with overflow returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with overflow = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// Carrying past the maximum is reported instead of wrapping around
+	if _, err := converter.Convert("9223372036854775807.999"); !errors.Is(err, ErrExceedsMaxValue) {
+		t.Errorf("Convert(9223372036854775807.999) with overflow error = %v, expected exceeds max value", err)
+	}
+
+	for digits, expected := range map[string]string{"0": "1", "9": "10", "199": "200", "999": "1000", "9223372036854775807": "9223372036854775808"} {
+		if result := incrementDigits(digits); result != expected {
+			t.Errorf("incrementDigits(%s) = %s, expected %s", digits, result, expected)
+		}
+	}
+}
+
 func TestDebugLargeNumbers(t *testing.T) {
 	// Test digit level handling for different positions
 	testCases := []struct {
@@ -656,3 +1068,1588 @@ func TestDebugLargeNumbers(t *testing.T) {
 		t.Logf("%s (%v) → %s", tc.description, tc.input, result)
 	}
 }
+
+func TestEstimateCost(t *testing.T) {
+	inputs := []any{"1", "12", "123.45", "1,234,567.89", "9223372036854775807"}
+
+	previous := 0
+	for _, input := range inputs {
+		cost, err := EstimateCost(input)
+		if err != nil {
+			t.Errorf("EstimateCost(%v) returned error: %v", input, err)
+			continue
+		}
+		if cost <= previous {
+			t.Errorf("EstimateCost(%v) = %d, expected more than %d", input, cost, previous)
+		}
+		previous = cost
+	}
+
+	if cost, err := EstimateCost("0"); err != nil || cost != 1 {
+		t.Errorf("EstimateCost(0) = %d, %v, expected 1, nil", cost, err)
+	}
+
+	invalid := []any{"", "abc", "1.2.3", "100000000000000000000", []int{1}}
+	for _, input := range invalid {
+		if _, err := EstimateCost(input); err == nil {
+			t.Errorf("EstimateCost(%v) expected error, got nil", input)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// Disable warning logs for cleaner test output
+	originalLogSetting := EnableWarningLogs
+	defer func() { EnableWarningLogs = originalLogSetting }()
+	SetWarningLogs(false)
+
+	inputs := []any{
+		"1", "123.45", "1,234,567.89", "-0.005", "๑๒๓", "1.5e3", 42, 3.14,
+		"9223372036854775807.999", "", "abc", "1.2.3", "100000000000000000000", []int{1}, nil,
+	}
+	for _, tc := range convertTestCases {
+		inputs = append(inputs, tc.input)
+	}
+
+	check := func(allowOverflow bool) {
+		for _, input := range inputs {
+			validateErr := Validate(input)
+			_, convertErr := Convert(input)
+			if (validateErr == nil) != (convertErr == nil) {
+				t.Errorf("Validate(%v) with AllowOverflow=%v = %v, but Convert returned %v", input, allowOverflow, validateErr, convertErr)
+				continue
+			}
+			if validateErr != nil && validateErr.Error() != convertErr.Error() {
+				t.Errorf("Validate(%v) with AllowOverflow=%v = %v, expected %v", input, allowOverflow, validateErr, convertErr)
+			}
+		}
+	}
+	check(false)
+
+	// A satang carry can push an amount at the limit past it
+	SetAllowOverflow(true)
+	defer SetAllowOverflow(false)
+	check(true)
+	if err := Validate("9223372036854775807.999"); !errors.Is(err, ErrExceedsMaxValue) {
+		t.Errorf("Validate(9223372036854775807.999) with AllowOverflow = %v, expected exceeds max value", err)
+	}
+}
+
+func TestSpaceBeforeMillion(t *testing.T) {
+	tests := []struct {
+		input    string
+		spaced   bool
+		expected string
+	}{
+		{"1234567", false, "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทถ้วน"},
+		{"1234567", true, "หนึ่ง ล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทถ้วน"},
+		{"1,234,567,889,999,999,999", true, "หนึ่ง ล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ด ล้านแปดแสนแปดหมื่นเก้าพันเก้าร้อยเก้าสิบเก้า ล้านเก้าแสนเก้าหมื่นเก้าพันเก้าร้อยเก้าสิบเก้าบาทถ้วน"},
+		{"1,000,000,000,000", true, "หนึ่ง ล้าน ล้านบาทถ้วน"},
+		{"123", true, "หนึ่งร้อยยี่สิบสามบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{SpaceBeforeMillion: test.spaced})
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) with SpaceBeforeMillion=%v returned error: %v", test.input, test.spaced, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with SpaceBeforeMillion=%v = %s, expected %s", test.input, test.spaced, result, test.expected)
+		}
+	}
+}
+
+func TestSparseMillionGroups(t *testing.T) {
+	// Every six-digit boundary reads one "ล้าน" once a non-zero digit sits to
+	// its left, whether or not the groups in between are zero
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1000000000000", "หนึ่งล้านล้านบาทถ้วน"},
+		{"1000000000001", "หนึ่งล้านล้านเอ็ดบาทถ้วน"},
+		{"1000001000000", "หนึ่งล้านเอ็ดล้านบาทถ้วน"},
+		{"1000001000001", "หนึ่งล้านเอ็ดล้านเอ็ดบาทถ้วน"},
+		{"2000000000021", "สองล้านล้านยี่สิบเอ็ดบาทถ้วน"},
+		{"5000000500000", "ห้าล้านล้านห้าแสนบาทถ้วน"},
+		{"1000000000000000001", "หนึ่งล้านล้านล้านเอ็ดบาทถ้วน"},
+		{"1000000000001000000", "หนึ่งล้านล้านเอ็ดล้านบาทถ้วน"},
+		{"1000001000000000000", "หนึ่งล้านเอ็ดล้านล้านบาทถ้วน"},
+		{"3000000000000.50", "สามล้านล้านบาทห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+
+		// The reading must parse back to the value it was made from
+		normalized, _ := normalizeAmount(test.input, RoundHalf, convertOptions{})
+		if parsed, err := Parse(result); err != nil || parsed != normalized.decimalString() {
+			t.Errorf("Parse(%s) = %s, %v, expected %s", result, parsed, err, normalized.decimalString())
+		}
+	}
+}
+
+func TestTwentiesInMillionsGroup(t *testing.T) {
+	onesWords := []string{"", "เอ็ด", "สอง", "สาม", "สี่", "ห้า", "หก", "เจ็ด", "แปด", "เก้า"}
+	tensWords := []string{"", "สิบ", "ยี่สิบ", "สามสิบ", "สี่สิบ", "ห้าสิบ", "หกสิบ", "เจ็ดสิบ", "แปดสิบ", "เก้าสิบ"}
+
+	for digit := 0; digit <= 9; digit++ {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{fmt.Sprintf("2%d,000,000", digit), "ยี่สิบ" + onesWords[digit] + "ล้านบาทถ้วน"},
+			{fmt.Sprintf("12%d,000,000", digit), "หนึ่งร้อยยี่สิบ" + onesWords[digit] + "ล้านบาทถ้วน"},
+			{fmt.Sprintf("2%d0,000,000", digit), "สองร้อย" + tensWords[digit] + "ล้านบาทถ้วน"},
+			{fmt.Sprintf("2%d,000,000,000,000", digit), "ยี่สิบ" + onesWords[digit] + "ล้านล้านบาทถ้วน"},
+			{fmt.Sprintf("2%d,000,001", digit), "ยี่สิบ" + onesWords[digit] + "ล้านเอ็ดบาทถ้วน"},
+		}
+
+		for _, test := range tests {
+			result, err := Convert(test.input)
+			if err != nil {
+				t.Errorf("Convert(%s) returned error: %v", test.input, err)
+				continue
+			}
+			if result != test.expected {
+				t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.expected)
+			}
+		}
+	}
+}
+
+func TestShortDecimalRounding(t *testing.T) {
+	modes := []DecimalRoundingMode{RoundHalf, RoundDown, RoundUp}
+
+	// One and two decimal digits have nothing to round, so every mode must agree
+	unrounded := []struct {
+		input    string
+		expected string
+	}{
+		{"100.5", "หนึ่งร้อยบาทห้าสิบสตางค์"},
+		{"100.05", "หนึ่งร้อยบาทห้าสตางค์"},
+		{"100.9", "หนึ่งร้อยบาทเก้าสิบสตางค์"},
+		{"100.99", "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์"},
+	}
+
+	for _, test := range unrounded {
+		for _, mode := range modes {
+			result, err := Convert(test.input, mode)
+			if err != nil {
+				t.Errorf("Convert(%s, %v) returned error: %v", test.input, mode, err)
+				continue
+			}
+			if result != test.expected {
+				t.Errorf("Convert(%s, %v) = %s, expected %s", test.input, mode, result, test.expected)
+			}
+		}
+	}
+
+	// Three decimal digits go through the rounding path
+	rounded := []struct {
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{RoundHalf, "หนึ่งร้อยบาทหนึ่งสตางค์"},
+		{RoundDown, "หนึ่งร้อยบาทถ้วน"},
+		{RoundUp, "หนึ่งร้อยบาทหนึ่งสตางค์"},
+	}
+
+	for _, test := range rounded {
+		result, err := Convert("100.005", test.mode)
+		if err != nil {
+			t.Errorf("Convert(100.005, %v) returned error: %v", test.mode, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(100.005, %v) = %s, expected %s", test.mode, result, test.expected)
+		}
+	}
+}
+
+func TestVerboseZeros(t *testing.T) {
+	tests := []struct {
+		input    string
+		verbose  bool
+		expected string
+	}{
+		{"101", false, "หนึ่งร้อยเอ็ดบาทถ้วน"},
+		{"101", true, "หนึ่งร้อยศูนย์สิบเอ็ดบาทถ้วน"},
+		{"1,000,001", false, "หนึ่งล้านเอ็ดบาทถ้วน"},
+		{"1,000,001", true, "หนึ่งล้านศูนย์แสนศูนย์หมื่นศูนย์พันศูนย์ร้อยศูนย์สิบเอ็ดบาทถ้วน"},
+		{"1,000,000", true, "หนึ่งล้านบาทถ้วน"}, // trailing zeros stay silent
+		{"100", true, "หนึ่งร้อยบาทถ้วน"},
+		{"2,005", true, "สองพันศูนย์ร้อยศูนย์สิบห้าบาทถ้วน"},
+		// A ones-place zero before ล้าน has no unit and is skipped
+		{"10,000,001", true, "สิบล้านศูนย์แสนศูนย์หมื่นศูนย์พันศูนย์ร้อยศูนย์สิบเอ็ดบาทถ้วน"},
+		{"1,000,000,000,001", true, "หนึ่งล้านศูนย์แสนศูนย์หมื่นศูนย์พันศูนย์ร้อยศูนย์สิบล้านศูนย์แสนศูนย์หมื่นศูนย์พันศูนย์ร้อยศูนย์สิบเอ็ดบาทถ้วน"},
+		{"20,000,000.5", true, "ยี่สิบล้านบาทห้าสิบสตางค์"},
+		{"0", true, "ศูนย์บาทถ้วน"},
+		{"101.05", true, "หนึ่งร้อยศูนย์สิบเอ็ดบาทห้าสตางค์"}, // satang is unaffected
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{VerboseZeros: test.verbose})
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) with VerboseZeros=%v returned error: %v", test.input, test.verbose, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with VerboseZeros=%v = %s, expected %s", test.input, test.verbose, result, test.expected)
+		}
+	}
+}
+
+func TestErrorOnRounding(t *testing.T) {
+	strict := NewConverter(&Config{ErrorOnRounding: true})
+
+	_, err := strict.Convert("100.456")
+	if convErr, ok := err.(*ConversionError); !ok || convErr.Code != ErrorCodeRoundingOccurred {
+		t.Errorf("Convert(100.456) with ErrorOnRounding expected ErrorCodeRoundingOccurred, got %v", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "beyond 2 decimal places") {
+		t.Errorf("Convert(100.456) with ErrorOnRounding error = %v, expected beyond 2 decimal places", err)
+	}
+
+	// The message follows MinorUnitDigits
+	_, err = NewConverter(&Config{ErrorOnRounding: true, MinorUnitDigits: 3}).Convert("100.4567")
+	if err == nil || !strings.Contains(err.Error(), "beyond 3 decimal places") {
+		t.Errorf("Convert(100.4567) with MinorUnitDigits 3 error = %v, expected beyond 3 decimal places", err)
+	}
+	_, err = NewConverter(&Config{ErrorOnRounding: true, MinorUnitDigits: 3, ErrorLanguage: ErrorLanguageThai}).Convert("100.4567")
+	if err == nil || !strings.Contains(err.Error(), "เกิน 3 ตำแหน่ง") {
+		t.Errorf("Convert(100.4567) with MinorUnitDigits 3 in Thai error = %v, expected เกิน 3 ตำแหน่ง", err)
+	}
+
+	accepted := []struct {
+		input    string
+		expected string
+	}{
+		{"100.45", "หนึ่งร้อยบาทสี่สิบห้าสตางค์"},
+		{"100.450", "หนึ่งร้อยบาทสี่สิบห้าสตางค์"}, // trailing zeros lose nothing
+		{"100", "หนึ่งร้อยบาทถ้วน"},
+	}
+	for _, test := range accepted {
+		result, err := strict.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) with ErrorOnRounding returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with ErrorOnRounding = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// Default converters keep rounding silently
+	result, err := NewDefaultConverter().Convert("100.456")
+	if err != nil {
+		t.Errorf("Convert(100.456) without ErrorOnRounding returned error: %v", err)
+	}
+	if expected := "หนึ่งร้อยบาทสี่สิบหกสตางค์"; result != expected {
+		t.Errorf("Convert(100.456) = %s, expected %s", result, expected)
+	}
+}
+
+func TestNegativeAmounts(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"-150", "ลบหนึ่งร้อยห้าสิบบาทถ้วน"},
+		{-150, "ลบหนึ่งร้อยห้าสิบบาทถ้วน"},
+		{int64(-1000000), "ลบหนึ่งล้านบาทถ้วน"},
+		{-12.5, "ลบสิบสองบาทห้าสิบสตางค์"},
+		{"-0.50", "ลบศูนย์บาทห้าสิบสตางค์"},
+		{"-0", "ศูนย์บาทถ้วน"},
+		{"-0.00", "ศูนย์บาทถ้วน"},
+		{"-0.001", "ศูนย์บาทถ้วน"}, // rounds to zero, so no sign
+		{" - 21 ", "ลบยี่สิบเอ็ดบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	converter := NewConverter(&Config{NegativePrefix: "ติดลบ"})
+	result, err := converter.Convert("-150")
+	if err != nil {
+		t.Errorf("Convert(-150) with NegativePrefix returned error: %v", err)
+	}
+	if expected := "ติดลบหนึ่งร้อยห้าสิบบาทถ้วน"; result != expected {
+		t.Errorf("Convert(-150) with NegativePrefix = %s, expected %s", result, expected)
+	}
+
+	for _, input := range []string{"1-2", "--5", "5-", "+-5"} {
+		if _, err := Convert(input); err == nil {
+			t.Errorf("Convert(%s) expected error for misplaced sign, got nil", input)
+		}
+	}
+}
+
+func TestCustomSeparators(t *testing.T) {
+	european := NewConverter(&Config{DecimalSeparator: ',', ThousandSeparator: '.'})
+	swiss := NewConverter(&Config{DecimalSeparator: '.', ThousandSeparator: '\''})
+
+	tests := []struct {
+		converter *Converter
+		input     any
+		expected  string
+	}{
+		{european, "1.234.567,89", "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทแปดสิบเก้าสตางค์"},
+		{european, "1.234", "หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน"},
+		{european, "-0,5", "ลบศูนย์บาทห้าสิบสตางค์"},
+		{european, "1,5e3", "หนึ่งพันห้าร้อยบาทถ้วน"},
+		{european, 12.5, "สิบสองบาทห้าสิบสตางค์"},
+		{swiss, "1'234.50", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์"},
+		{NewDefaultConverter(), "1,234.50", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := test.converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// Ambiguous input and equal separators are rejected
+	invalid := []struct {
+		converter *Converter
+		input     string
+	}{
+		{european, "1,234,56"},
+		{swiss, "1,234.50"},
+		{european, "1.234,5,6"},
+		{NewConverter(&Config{DecimalSeparator: ','}), "1,5"},
+		{NewConverter(&Config{DecimalSeparator: '.', ThousandSeparator: '.'}), "1.5"},
+	}
+	for _, test := range invalid {
+		if _, err := test.converter.Convert(test.input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%s) error = %v, expected invalid input", test.input, err)
+		}
+	}
+
+	// Amounts built by the package keep the standard separators
+	if result, err := european.ConvertFraction(5, 2); err != nil || result != "สองบาทห้าสิบสตางค์" {
+		t.Errorf("ConvertFraction(5, 2) with European separators = %s, %v", result, err)
+	}
+}
+
+func TestValidateGrouping(t *testing.T) {
+	converter := NewConverter(&Config{ValidateGrouping: true})
+
+	valid := []string{"1,000", "100,000", "1,234,567.89", "-12,345", "999", "1234567", " 1,000 ", "๑,๐๐๐"}
+	for _, input := range valid {
+		expected, _ := Convert(input)
+		if result, err := converter.Convert(input); err != nil || result != expected {
+			t.Errorf("Convert(%s) with ValidateGrouping = %s, %v, expected %s", input, result, err, expected)
+		}
+	}
+
+	malformed := []string{"1,00,000", "1000,000", "1,0000", ",100", "100,", "1,,000", "12,34.5"}
+	for _, input := range malformed {
+		if _, err := converter.Convert(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%s) with ValidateGrouping error = %v, expected invalid input", input, err)
+		}
+	}
+
+	// The default still strips every comma
+	if result, err := Convert("1,00,000"); err != nil || result != "หนึ่งแสนบาทถ้วน" {
+		t.Errorf("Convert(1,00,000) = %s, %v, expected หนึ่งแสนบาทถ้วน", result, err)
+	}
+}
+
+func TestRejectCommas(t *testing.T) {
+	converter := NewConverter(&Config{RejectCommas: true})
+
+	rejected := []string{"12,34", "1,234.56", "-1,000", ","}
+	for _, input := range rejected {
+		if _, err := converter.Convert(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%s) with RejectCommas error = %v, expected invalid input", input, err)
+		}
+	}
+
+	_, err := converter.Convert("12,34")
+	if err == nil || !strings.Contains(err.Error(), "invalid character ',' (U+002C) at position 2") {
+		t.Errorf("Convert(12,34) with RejectCommas error = %v, expected the comma position", err)
+	}
+
+	// The configured thousand separator is reported as typed
+	dotted := NewConverter(&Config{RejectCommas: true, DecimalSeparator: ',', ThousandSeparator: '.'})
+	if _, err := dotted.Convert("1.234,5"); err == nil || !strings.Contains(err.Error(), "invalid character '.'") {
+		t.Errorf("Convert(1.234,5) with RejectCommas error = %v, expected invalid character '.'", err)
+	}
+	if result, err := dotted.Convert("1234,5"); err != nil || result != "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์" {
+		t.Errorf("Convert(1234,5) with RejectCommas = %s, %v, expected หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์", result, err)
+	}
+
+	if result, err := converter.Convert("1234.5"); err != nil || result != "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์" {
+		t.Errorf("Convert(1234.5) with RejectCommas = %s, %v, expected หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์", result, err)
+	}
+
+	// The default still strips every comma
+	if result, err := Convert("12,34"); err != nil || result != "หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน" {
+		t.Errorf("Convert(12,34) = %s, %v, expected หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน", result, err)
+	}
+}
+
+func TestStrictParsing(t *testing.T) {
+	strict := NewConverter(&Config{StrictParsing: true})
+	lenient := NewDefaultConverter()
+
+	loose := []string{"1..2", "1_2,3", " 12", "12 ", "1\t2", "+12", ".5", "12.", "1,23,456", "๑๒", "1e3", "--1"}
+	for _, input := range loose {
+		if _, err := strict.Convert(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%q) with StrictParsing error = %v, expected invalid input", input, err)
+		}
+	}
+
+	// Everything lenient mode normalizes is still accepted without StrictParsing
+	for _, input := range loose {
+		if input == "1..2" || input == "--1" {
+			continue
+		}
+		if _, err := lenient.Convert(input); err != nil {
+			t.Errorf("Convert(%q) without StrictParsing returned error: %v", input, err)
+		}
+	}
+
+	clean := map[string]string{
+		"12":            "สิบสองบาทถ้วน",
+		"-12.50":        "ลบสิบสองบาทห้าสิบสตางค์",
+		"1,234,567.891": "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดบาทแปดสิบเก้าสตางค์",
+		"0.5":           "ศูนย์บาทห้าสิบสตางค์",
+	}
+	for input, expected := range clean {
+		result, err := strict.Convert(input)
+		if err != nil {
+			t.Errorf("Convert(%s) with StrictParsing returned error: %v", input, err)
+			continue
+		}
+		if result != expected {
+			t.Errorf("Convert(%s) with StrictParsing = %s, expected %s", input, result, expected)
+		}
+	}
+
+	// Numeric types are formatted by the package and never rejected
+	if _, err := strict.Convert(0.5); err != nil {
+		t.Errorf("Convert(0.5) with StrictParsing returned error: %v", err)
+	}
+}
+
+func TestInvalidCharacterPosition(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"12a3", "invalid character 'a' (U+0061) at position 2"},
+		{"๑๒ก3", "invalid character 'ก' (U+0E01) at position 2"},
+		{"บาท5", "invalid character 'บ' (U+0E1A) at position 0"},
+		{"1€", "invalid character '€' (U+20AC) at position 1"},
+	}
+
+	for _, test := range tests {
+		_, err := Convert(test.input)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Convert(%s) error = %v, expected it to contain %q", test.input, err, test.expected)
+		}
+	}
+}
+
+// TestSignWithGrouping pins the interaction of the sign, comma and decimal
+// point handling in sanitizeInput
+func TestSignWithGrouping(t *testing.T) {
+	expected, err := Convert("1234.50")
+	if err != nil {
+		t.Fatalf("Convert(1234.50) returned error: %v", err)
+	}
+
+	for _, input := range []string{"+1,234.50", "+1234.50", "1,234.50", " +1,234.50 "} {
+		result, err := Convert(input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", input, err)
+			continue
+		}
+		if result != expected {
+			t.Errorf("Convert(%s) = %s, expected %s", input, result, expected)
+		}
+	}
+
+	for _, input := range []string{"+1,234.5,0", "-1,234.5,0", "+1,234.,50", "+,1234.50.1"} {
+		_, err := Convert(input)
+		if !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%s) error = %v, expected invalid input", input, err)
+		}
+	}
+}
+
+func TestTreatEmptyAsZero(t *testing.T) {
+	converter := NewConverter(&Config{TreatEmptyAsZero: true})
+	blanks := []string{"", " ", "\t", " \t  \t", "\n"}
+
+	for _, input := range blanks {
+		result, err := converter.Convert(input)
+		if err != nil {
+			t.Errorf("Convert(%q) with TreatEmptyAsZero returned error: %v", input, err)
+			continue
+		}
+		if expected := "ศูนย์บาทถ้วน"; result != expected {
+			t.Errorf("Convert(%q) with TreatEmptyAsZero = %s, expected %s", input, result, expected)
+		}
+
+		if _, err := Convert(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%q) error = %v, expected invalid input", input, err)
+		}
+		if _, err := NewDefaultConverter().Convert(input); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%q) without TreatEmptyAsZero error = %v, expected invalid input", input, err)
+		}
+	}
+
+	// Other input is unaffected
+	if _, err := converter.Convert("abc"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Convert(abc) with TreatEmptyAsZero error = %v, expected invalid input", err)
+	}
+	if result, _ := converter.Convert(" 5 "); result != "ห้าบาทถ้วน" {
+		t.Errorf("Convert(\" 5 \") with TreatEmptyAsZero = %s, expected ห้าบาทถ้วน", result)
+	}
+}
+
+func TestSuffix(t *testing.T) {
+	converter := NewConverter(&Config{Suffix: " (รวมภาษีมูลค่าเพิ่ม)"})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"107", "หนึ่งร้อยเจ็ดบาทถ้วน (รวมภาษีมูลค่าเพิ่ม)"},
+		{"1,070.50", "หนึ่งพันเจ็ดสิบบาทห้าสิบสตางค์ (รวมภาษีมูลค่าเพิ่ม)"},
+		{"-107", "ลบหนึ่งร้อยเจ็ดบาทถ้วน (รวมภาษีมูลค่าเพิ่ม)"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) with Suffix returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with Suffix = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	result, err := converter.ConvertForPayment("107")
+	if err != nil {
+		t.Errorf("ConvertForPayment(107) with Suffix returned error: %v", err)
+	}
+	if expected := "หนึ่งร้อยเจ็ดบาท (รวมภาษีมูลค่าเพิ่ม)"; result != expected {
+		t.Errorf("ConvertForPayment(107) with Suffix = %s, expected %s", result, expected)
+	}
+}
+
+func TestOmitEvenSuffix(t *testing.T) {
+	converter := NewConverter(&Config{OmitEvenSuffix: true})
+
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"100", "หนึ่งร้อยบาท"},
+		{"100.00", "หนึ่งร้อยบาท"},
+		{"100.001", "หนึ่งร้อยบาท"},
+		{0, "ศูนย์บาท"},
+		{"-21", "ลบยี่สิบเอ็ดบาท"},
+		{"100.50", "หนึ่งร้อยบาทห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) with OmitEvenSuffix returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) with OmitEvenSuffix = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	if result, _ := NewDefaultConverter().Convert("100"); result != "หนึ่งร้อยบาทถ้วน" {
+		t.Errorf("Convert(100) without OmitEvenSuffix = %s, expected หนึ่งร้อยบาทถ้วน", result)
+	}
+}
+
+func TestNegativeParentheses(t *testing.T) {
+	tests := []struct {
+		config   Config
+		input    string
+		expected string
+	}{
+		{Config{NegativeStyle: NegativeParentheses}, "-100", "(หนึ่งร้อยบาทถ้วน)"},
+		{Config{NegativeStyle: NegativeParentheses}, "-100.50", "(หนึ่งร้อยบาทห้าสิบสตางค์)"},
+		{Config{NegativeStyle: NegativeParentheses}, "100", "หนึ่งร้อยบาทถ้วน"},
+		{Config{NegativeStyle: NegativeParentheses, LegalNumerals: true}, "-100", "(หนึ่งร้อยบาทถ้วน) (-100.00)"},
+		{Config{NegativeStyle: NegativeParentheses, LegalNumerals: true}, "-100.50", "(หนึ่งร้อยบาทห้าสิบสตางค์) (-100.50)"},
+		{Config{NegativeStyle: NegativeParentheses, LegalNumerals: true}, "100.50", "หนึ่งร้อยบาทห้าสิบสตางค์ (100.50)"},
+		{Config{NegativeStyle: NegativeParentheses, NegativePrefix: "ติดลบ", Suffix: " สุทธิ"}, "-100", "(หนึ่งร้อยบาทถ้วน สุทธิ)"},
+		{Config{NegativeStyle: NegativeParentheses, CurrencyFirst: true}, "-100.50", "(บาท หนึ่งร้อย ห้าสิบสตางค์)"},
+		{Config{LegalNumerals: true}, "-1234.5", "ลบหนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบสตางค์ (-1,234.50)"},
+		{Config{LegalNumerals: true}, "0", "ศูนย์บาทถ้วน (0.00)"},
+	}
+
+	for _, test := range tests {
+		result, err := NewConverter(&test.config).Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with %+v = %s, expected %s", test.input, test.config, result, test.expected)
+		}
+	}
+}
+
+func TestShowPositivePrefix(t *testing.T) {
+	tests := []struct {
+		config   Config
+		input    string
+		expected string
+	}{
+		{Config{}, "+150", "หนึ่งร้อยห้าสิบบาทถ้วน"},
+		{Config{ShowPositivePrefix: true}, "+150", "บวกหนึ่งร้อยห้าสิบบาทถ้วน"},
+		{Config{ShowPositivePrefix: true}, "150.25", "บวกหนึ่งร้อยห้าสิบบาทยี่สิบห้าสตางค์"},
+		{Config{ShowPositivePrefix: true}, "-150", "ลบหนึ่งร้อยห้าสิบบาทถ้วน"},
+		{Config{ShowPositivePrefix: true}, "+0", "ศูนย์บาทถ้วน"},
+		{Config{ShowPositivePrefix: true}, "0.004", "ศูนย์บาทถ้วน"},
+		{Config{ShowPositivePrefix: true, NegativeStyle: NegativeParentheses}, "-150", "(หนึ่งร้อยห้าสิบบาทถ้วน)"},
+		{Config{ShowPositivePrefix: true, CurrencyFirst: true}, "0.50", "บาท บวกศูนย์ ห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := NewConverter(&test.config).Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with %+v = %s, expected %s", test.input, test.config, result, test.expected)
+		}
+	}
+}
+
+func TestFloatShortestDecimal(t *testing.T) {
+	a, b := 0.1, 0.2
+	tests := []struct {
+		input    any
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		// 0.1+0.2 is 0.30000000000000004, so only RoundUp sees the error
+		{a + b, RoundHalf, "ศูนย์บาทสามสิบสตางค์"},
+		{a + b, RoundDown, "ศูนย์บาทสามสิบสตางค์"},
+		{a + b, RoundUp, "ศูนย์บาทสามสิบเอ็ดสตางค์"},
+		{"0.3", RoundUp, "ศูนย์บาทสามสิบสตางค์"},
+		// The shortest form of 1.005 is "1.005", not 1.00499999999999989...
+		{1.005, RoundHalf, "หนึ่งบาทหนึ่งสตางค์"},
+		{float32(0.1), RoundUp, "ศูนย์บาทสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input, test.mode)
+		if err != nil {
+			t.Errorf("Convert(%v, %v) returned error: %v", test.input, test.mode, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v, %v) = %s, expected %s", test.input, test.mode, result, test.expected)
+		}
+	}
+}
+
+func TestFloatMatchesStringRounding(t *testing.T) {
+	tests := []struct {
+		float  float64
+		string string
+	}{
+		{123.456, "123.456"},
+		{123.454, "123.454"},
+		{123.451, "123.451"},
+		{0.125, "0.125"},
+		{99.5, "99.5"},
+		{1000000.999, "1000000.999"},
+	}
+
+	originalLogSetting := EnableWarningLogs
+	EnableWarningLogs = false
+	defer func() { EnableWarningLogs = originalLogSetting }()
+
+	for _, test := range tests {
+		for _, mode := range []DecimalRoundingMode{RoundHalf, RoundDown, RoundUp} {
+			fromString, err := Convert(test.string, mode)
+			if err != nil {
+				t.Errorf("Convert(%q, %v) returned error: %v", test.string, mode, err)
+				continue
+			}
+			fromFloat, err := Convert(test.float, mode)
+			if err != nil {
+				t.Errorf("Convert(%v, %v) returned error: %v", test.float, mode, err)
+				continue
+			}
+			if fromFloat != fromString {
+				t.Errorf("Convert(%v, %v) = %s, but Convert(%q, %v) = %s", test.float, mode, fromFloat, test.string, mode, fromString)
+			}
+		}
+	}
+
+	// The specific case from the bug report
+	result, err := Convert(123.456, RoundDown)
+	if err != nil {
+		t.Errorf("Convert(123.456, RoundDown) returned error: %v", err)
+	}
+	if expected := "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"; result != expected {
+		t.Errorf("Convert(123.456, RoundDown) = %s, expected %s", result, expected)
+	}
+}
+
+func TestTieBreaker(t *testing.T) {
+	tiesDown := NewConverter(&Config{TieBreaker: func(value int) int { return value }})
+	tiesToOdd := NewConverter(&Config{TieBreaker: func(value int) int {
+		if value%2 == 1 {
+			return value
+		}
+		return value + 1
+	}})
+
+	tests := []struct {
+		converter *Converter
+		input     string
+		expected  string
+	}{
+		{tiesDown, "123.455", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},             // tie -> down
+		{tiesDown, "123.4550", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},            // still a tie
+		{tiesDown, "123.4551", "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"},             // above the tie rounds up normally
+		{tiesDown, "123.456", "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"},              // not a tie
+		{tiesDown, "123.454", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},             // not a tie
+		{tiesToOdd, "123.455", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},            // 45 is odd
+		{tiesToOdd, "123.445", "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},            // 44 -> 45
+		{NewDefaultConverter(), "123.455", "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"}, // default rounds ties up
+	}
+
+	for _, test := range tests {
+		result, err := test.converter.Convert(test.input, RoundHalf)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// The tie breaker only applies to RoundHalf
+	result, err := tiesDown.Convert("123.455", RoundUp)
+	if err != nil {
+		t.Errorf("Convert(123.455, RoundUp) returned error: %v", err)
+	}
+	if expected := "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"; result != expected {
+		t.Errorf("Convert(123.455, RoundUp) with TieBreaker = %s, expected %s", result, expected)
+	}
+
+	// A tie breaker may only round the tie down or up
+	for _, breaker := range []func(int) int{
+		func(value int) int { return -1 },
+		func(value int) int { return value + 2 },
+		func(value int) int { return value - 1 },
+	} {
+		converter := NewConverter(&Config{TieBreaker: breaker})
+		if result, err := converter.Convert("1.005"); !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), "TieBreaker") {
+			t.Errorf("Convert(1.005) with an out-of-range TieBreaker = %s, %v, expected an invalid input error", result, err)
+		}
+	}
+}
+
+func TestConcurrentConvertersDoNotShareSettings(t *testing.T) {
+	capped := NewConverter(&Config{AllowOverflow: false})
+	overflowing := NewConverter(&Config{AllowOverflow: true})
+
+	// Disable warning logs for cleaner test output
+	originalLogSetting := EnableWarningLogs
+	originalOverflowSetting := AllowOverflow
+	defer func() {
+		EnableWarningLogs = originalLogSetting
+		AllowOverflow = originalOverflowSetting
+	}()
+	SetWarningLogs(false)
+	SetAllowOverflow(false)
+
+	var wg sync.WaitGroup
+	check := func(name string, convert func() (string, error), expected string) {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			result, err := convert()
+			if err != nil {
+				t.Errorf("%s: Convert(100.995) returned error: %v", name, err)
+				return
+			}
+			if result != expected {
+				t.Errorf("%s: Convert(100.995) = %s, expected %s", name, result, expected)
+				return
+			}
+		}
+	}
+
+	wg.Add(3)
+	go check("capped", func() (string, error) { return capped.Convert("100.995") }, "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์")
+	go check("overflowing", func() (string, error) { return overflowing.Convert("100.995") }, "หนึ่งร้อยเอ็ดบาทถ้วน")
+	go check("global", func() (string, error) { return Convert("100.995") }, "หนึ่งร้อยบาทเก้าสิบเก้าสตางค์")
+	wg.Wait()
+
+	if AllowOverflow {
+		t.Errorf("Converter.Convert modified the global AllowOverflow setting")
+	}
+}
+
+func TestCeilFloorRounding(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{"1.234", RoundDown, "หนึ่งบาทยี่สิบสามสตางค์"},
+		{"1.234", RoundUp, "หนึ่งบาทยี่สิบสี่สตางค์"},
+		{"1.234", RoundCeil, "หนึ่งบาทยี่สิบสี่สตางค์"},
+		{"1.234", RoundFloor, "หนึ่งบาทยี่สิบสามสตางค์"},
+		{"-1.234", RoundDown, "ลบหนึ่งบาทยี่สิบสามสตางค์"},
+		{"-1.234", RoundUp, "ลบหนึ่งบาทยี่สิบสี่สตางค์"},
+		{"-1.234", RoundCeil, "ลบหนึ่งบาทยี่สิบสามสตางค์"},
+		{"-1.234", RoundFloor, "ลบหนึ่งบาทยี่สิบสี่สตางค์"},
+		{"-0.001", RoundFloor, "ลบศูนย์บาทหนึ่งสตางค์"},
+		{"-0.009", RoundCeil, "ศูนย์บาทถ้วน"},
+		{"2.50", RoundCeil, "สองบาทห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input, test.mode)
+		if err != nil {
+			t.Errorf("Convert(%s, %d) returned error: %v", test.input, test.mode, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s, %d) = %s, expected %s", test.input, test.mode, result, test.expected)
+		}
+	}
+}
+
+func TestHalfUpRounding(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     DecimalRoundingMode
+		expected string
+	}{
+		{"1.235", RoundHalfUp, "หนึ่งบาทยี่สิบสี่สตางค์"},
+		{"1.234", RoundHalfUp, "หนึ่งบาทยี่สิบสามสตางค์"},
+		{"-1.235", RoundHalfUp, "ลบหนึ่งบาทยี่สิบสามสตางค์"},
+		{"-1.2350", RoundHalfUp, "ลบหนึ่งบาทยี่สิบสามสตางค์"},
+		{"-1.2351", RoundHalfUp, "ลบหนึ่งบาทยี่สิบสี่สตางค์"},
+		{"-1.236", RoundHalfUp, "ลบหนึ่งบาทยี่สิบสี่สตางค์"},
+		{"-0.005", RoundHalfUp, "ศูนย์บาทถ้วน"},
+		{"1.235", RoundHalfAwayFromZero, "หนึ่งบาทยี่สิบสี่สตางค์"},
+		{"-1.235", RoundHalfAwayFromZero, "ลบหนึ่งบาทยี่สิบสี่สตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input, test.mode)
+		if err != nil {
+			t.Errorf("Convert(%s, %d) returned error: %v", test.input, test.mode, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s, %d) = %s, expected %s", test.input, test.mode, result, test.expected)
+		}
+	}
+
+	// RoundHalf keeps its behavior as an alias
+	for _, input := range []string{"1.235", "-1.235", "-0.005"} {
+		half, _ := Convert(input, RoundHalf)
+		if away, _ := Convert(input, RoundHalfAwayFromZero); half != away {
+			t.Errorf("Convert(%s, RoundHalfAwayFromZero) = %s, expected RoundHalf reading %s", input, away, half)
+		}
+	}
+}
+
+func TestConvertParts(t *testing.T) {
+	// Disable warning logs for cleaner test output
+	originalLogSetting := EnableWarningLogs
+	defer func() { EnableWarningLogs = originalLogSetting }()
+	SetWarningLogs(false)
+
+	tests := []struct {
+		input    any
+		expected Result
+	}{
+		{"123.45", Result{BahtText: "หนึ่งร้อยยี่สิบสาม", SatangText: "สี่สิบห้า", Integer: "123", Decimal: "45"}},
+		{"100", Result{BahtText: "หนึ่งร้อย", IsEven: true, Integer: "100", Decimal: "00"}},
+		{"1,000.00", Result{BahtText: "หนึ่งพัน", IsEven: true, Integer: "1000", Decimal: "00"}},
+		{"0.5", Result{BahtText: "ศูนย์", SatangText: "ห้าสิบ", Integer: "0", Decimal: "50"}},
+		{"-21.999", Result{Negative: true, BahtText: "ยี่สิบเอ็ด", SatangText: "เก้าสิบเก้า", Integer: "21", Decimal: "99"}},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertParts(test.input)
+		if err != nil {
+			t.Errorf("ConvertParts(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertParts(%v) = %+v, expected %+v", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := ConvertParts("abc"); err == nil {
+		t.Errorf("ConvertParts(abc) expected error, got nil")
+	}
+
+	converter := NewConverter(&Config{DefaultRounding: RoundDown})
+	result, err := converter.ConvertParts("1.239")
+	if err != nil {
+		t.Fatalf("ConvertParts(1.239) returned error: %v", err)
+	}
+	if result.Decimal != "23" || result.SatangText != "ยี่สิบสาม" {
+		t.Errorf("ConvertParts(1.239) with RoundDown = %+v, expected 23 satang", result)
+	}
+}
+
+func TestCurrencyFirst(t *testing.T) {
+	tests := []struct {
+		input       string
+		satangFirst bool
+		expected    string
+	}{
+		{"123.45", false, "บาท หนึ่งร้อยยี่สิบสาม สี่สิบห้าสตางค์"},
+		{"123.45", true, "บาท หนึ่งร้อยยี่สิบสาม สตางค์ สี่สิบห้า"},
+		{"123", false, "บาท หนึ่งร้อยยี่สิบสามถ้วน"},
+		{"123", true, "บาท หนึ่งร้อยยี่สิบสามถ้วน"},
+		{"-0.50", false, "บาท ลบศูนย์ ห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{CurrencyFirst: true, SatangFirst: test.satangFirst})
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with CurrencyFirst = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	// SatangFirst alone keeps the standard order
+	converter := NewConverter(&Config{SatangFirst: true})
+	result, _ := converter.Convert("123.45")
+	if expected := "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"; result != expected {
+		t.Errorf("Convert(123.45) with only SatangFirst = %s, expected %s", result, expected)
+	}
+}
+
+func TestTeensAcrossGroups(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// Lowest group
+		{"11", "สิบเอ็ดบาทถ้วน"},
+		{"12", "สิบสองบาทถ้วน"},
+		{"111", "หนึ่งร้อยสิบเอ็ดบาทถ้วน"},
+		{"1011", "หนึ่งพันสิบเอ็ดบาทถ้วน"},
+		{"10011", "หนึ่งหมื่นสิบเอ็ดบาทถ้วน"},
+		// Million group
+		{"11000000", "สิบเอ็ดล้านบาทถ้วน"},
+		{"19000000", "สิบเก้าล้านบาทถ้วน"},
+		{"111000000", "หนึ่งร้อยสิบเอ็ดล้านบาทถ้วน"},
+		{"110000011", "หนึ่งร้อยสิบล้านสิบเอ็ดบาทถ้วน"},
+		{"1012000", "หนึ่งล้านหนึ่งหมื่นสองพันบาทถ้วน"},
+		{"11011011", "สิบเอ็ดล้านหนึ่งหมื่นหนึ่งพันสิบเอ็ดบาทถ้วน"},
+		{"12012012012", "หนึ่งหมื่นสองพันสิบสองล้านหนึ่งหมื่นสองพันสิบสองบาทถ้วน"},
+		// Million-million group
+		{"11000000000000", "สิบเอ็ดล้านล้านบาทถ้วน"},
+		{"11000011000000", "สิบเอ็ดล้านสิบเอ็ดล้านบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestConfigurableUnits(t *testing.T) {
+	converter := NewConverter(&Config{
+		DefaultRounding: RoundHalf,
+		MajorUnit:       "ดอลลาร์",
+		MinorUnit:       "เซ็นต์",
+	})
+
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"123.45", "หนึ่งร้อยยี่สิบสามดอลลาร์สี่สิบห้าเซ็นต์"},
+		{"100", "หนึ่งร้อยดอลลาร์ถ้วน"},
+		{"0.01", "ศูนย์ดอลลาร์หนึ่งเซ็นต์"},
+		{"-5", "ลบห้าดอลลาร์ถ้วน"},
+	}
+
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) with USD units = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	converter = NewConverter(&Config{MajorUnit: "เยน", MinorUnit: "เซ็น", EvenSuffix: "พอดี"})
+	result, _ := converter.Convert("500")
+	if expected := "ห้าร้อยเยนพอดี"; result != expected {
+		t.Errorf("Convert(500) with EvenSuffix = %s, expected %s", result, expected)
+	}
+
+	// The global function keeps the baht words
+	result, _ = Convert("123.45")
+	if expected := "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"; result != expected {
+		t.Errorf("Convert(123.45) = %s, expected %s", result, expected)
+	}
+}
+
+func TestElideLeadingOne(t *testing.T) {
+	tests := []struct {
+		input    string
+		standard string
+		elided   string
+	}{
+		{"100000", "หนึ่งแสนบาทถ้วน", "แสนบาทถ้วน"},
+		{"147521", "หนึ่งแสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทถ้วน", "แสนสี่หมื่นเจ็ดพันห้าร้อยยี่สิบเอ็ดบาทถ้วน"},
+		{"10000", "หนึ่งหมื่นบาทถ้วน", "หมื่นบาทถ้วน"},
+		{"1500", "หนึ่งพันห้าร้อยบาทถ้วน", "พันห้าร้อยบาทถ้วน"},
+		{"100", "หนึ่งร้อยบาทถ้วน", "ร้อยบาทถ้วน"},
+		{"100000000", "หนึ่งร้อยล้านบาทถ้วน", "ร้อยล้านบาทถ้วน"},
+		// Only the leading digit, and never the ones or tens place
+		{"1", "หนึ่งบาทถ้วน", "หนึ่งบาทถ้วน"},
+		{"1000000", "หนึ่งล้านบาทถ้วน", "หนึ่งล้านบาทถ้วน"},
+		{"247100", "สองแสนสี่หมื่นเจ็ดพันหนึ่งร้อยบาทถ้วน", "สองแสนสี่หมื่นเจ็ดพันหนึ่งร้อยบาทถ้วน"},
+	}
+
+	standard := NewConverter(&Config{})
+	elided := NewConverter(&Config{ElideLeadingOne: true})
+	for _, test := range tests {
+		if result, _ := standard.Convert(test.input); result != test.standard {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.standard)
+		}
+		if result, _ := elided.Convert(test.input); result != test.elided {
+			t.Errorf("Convert(%s) with ElideLeadingOne = %s, expected %s", test.input, result, test.elided)
+		}
+	}
+}
+
+// satangReadings is the reading of every satang value from 00 to 99, written
+// out in full so it can be checked by eye against the Thai rules. 00 has no
+// reading because whole amounts end in "ถ้วน".
+var satangReadings = [100]string{
+	"", "หนึ่ง", "สอง", "สาม", "สี่", "ห้า", "หก", "เจ็ด", "แปด", "เก้า", // 00-09
+	"สิบ", "สิบเอ็ด", "สิบสอง", "สิบสาม", "สิบสี่", "สิบห้า", "สิบหก", "สิบเจ็ด", "สิบแปด", "สิบเก้า", // 10-19
+	"ยี่สิบ", "ยี่สิบเอ็ด", "ยี่สิบสอง", "ยี่สิบสาม", "ยี่สิบสี่", "ยี่สิบห้า", "ยี่สิบหก", "ยี่สิบเจ็ด", "ยี่สิบแปด", "ยี่สิบเก้า", // 20-29
+	"สามสิบ", "สามสิบเอ็ด", "สามสิบสอง", "สามสิบสาม", "สามสิบสี่", "สามสิบห้า", "สามสิบหก", "สามสิบเจ็ด", "สามสิบแปด", "สามสิบเก้า", // 30-39
+	"สี่สิบ", "สี่สิบเอ็ด", "สี่สิบสอง", "สี่สิบสาม", "สี่สิบสี่", "สี่สิบห้า", "สี่สิบหก", "สี่สิบเจ็ด", "สี่สิบแปด", "สี่สิบเก้า", // 40-49
+	"ห้าสิบ", "ห้าสิบเอ็ด", "ห้าสิบสอง", "ห้าสิบสาม", "ห้าสิบสี่", "ห้าสิบห้า", "ห้าสิบหก", "ห้าสิบเจ็ด", "ห้าสิบแปด", "ห้าสิบเก้า", // 50-59
+	"หกสิบ", "หกสิบเอ็ด", "หกสิบสอง", "หกสิบสาม", "หกสิบสี่", "หกสิบห้า", "หกสิบหก", "หกสิบเจ็ด", "หกสิบแปด", "หกสิบเก้า", // 60-69
+	"เจ็ดสิบ", "เจ็ดสิบเอ็ด", "เจ็ดสิบสอง", "เจ็ดสิบสาม", "เจ็ดสิบสี่", "เจ็ดสิบห้า", "เจ็ดสิบหก", "เจ็ดสิบเจ็ด", "เจ็ดสิบแปด", "เจ็ดสิบเก้า", // 70-79
+	"แปดสิบ", "แปดสิบเอ็ด", "แปดสิบสอง", "แปดสิบสาม", "แปดสิบสี่", "แปดสิบห้า", "แปดสิบหก", "แปดสิบเจ็ด", "แปดสิบแปด", "แปดสิบเก้า", // 80-89
+	"เก้าสิบ", "เก้าสิบเอ็ด", "เก้าสิบสอง", "เก้าสิบสาม", "เก้าสิบสี่", "เก้าสิบห้า", "เก้าสิบหก", "เก้าสิบเจ็ด", "เก้าสิบแปด", "เก้าสิบเก้า", // 90-99
+}
+
+func TestConvertDecimalPartExhaustive(t *testing.T) {
+	// Disable warning logs for cleaner test output
+	originalLogSetting := EnableWarningLogs
+	defer func() { EnableWarningLogs = originalLogSetting }()
+	SetWarningLogs(false)
+
+	for value, expected := range satangReadings {
+		decimal := fmt.Sprintf("%02d", value)
+		if result := convertDecimalPart(decimal); result != expected {
+			t.Errorf("convertDecimalPart(%s) = %s, expected %s", decimal, result, expected)
+		}
+
+		input := "7." + decimal
+		want := "เจ็ดบาท" + expected + "สตางค์"
+		if value == 0 {
+			want = "เจ็ดบาทถ้วน"
+		}
+		if result, err := Convert(input); err != nil || result != want {
+			t.Errorf("Convert(%s) = %s, %v, expected %s", input, result, err, want)
+		}
+
+		want = expected
+		if value == 0 {
+			want = "ศูนย์"
+		}
+		if result, err := ConvertSatang(value); err != nil || result != want {
+			t.Errorf("ConvertSatang(%d) = %s, %v, expected %s", value, result, err, want)
+		}
+	}
+	// Satang never reaches 100: it carries into baht or is capped at 99
+	if result, _ := NewConverter(&Config{AllowOverflow: true}).Convert("7.999"); result != "แปดบาทถ้วน" {
+		t.Errorf("Convert(7.999) with overflow = %s, expected แปดบาทถ้วน", result)
+	}
+	if result, _ := Convert("7.999"); result != "เจ็ดบาทเก้าสิบเก้าสตางค์" {
+		t.Errorf("Convert(7.999) = %s, expected เจ็ดบาทเก้าสิบเก้าสตางค์", result)
+	}
+}
+
+// TestSmallIntFastPath checks that integers taking the fast path read the
+// same as their string forms, which go through full parsing
+func TestSmallIntFastPath(t *testing.T) {
+	configs := []*Config{
+		DefaultConfig(),
+		{MinorUnitDigits: 3, SatangAsFraction: true, FractionForEven: true},
+		{NegativeStyle: NegativeParentheses, LegalNumerals: true},
+		{ZeroSatangStyle: ExplicitZeroSatang, CashRounding: "0.25"},
+		{MaxValue: "500"},
+	}
+	values := []int64{0, 1, 11, 21, 100, 101, 999_999, 1_000_000, -1, -21, -999_999, -1_000_000}
+
+	for _, config := range configs {
+		converter := NewConverter(config)
+		for _, value := range values {
+			expected, expectedErr := converter.Convert(strconv.FormatInt(value, 10))
+			for _, amount := range []any{int(value), int32(value), value} {
+				result, err := converter.Convert(amount)
+				if result != expected || (err == nil) != (expectedErr == nil) {
+					t.Errorf("Convert(%T %d) with %+v = %s, %v, expected %s, %v", amount, value, *config, result, err, expected, expectedErr)
+				}
+			}
+		}
+	}
+}
+
+// TestOneToHundred checks every baht value from 1 to 100 against the
+// satang table, which follows the same rules: a 1 after the tens is "เอ็ด",
+// a lone 1 is "หนึ่ง" and 10 is "สิบ", never "หนึ่งสิบ"
+func TestOneToHundred(t *testing.T) {
+	for value := 1; value <= 100; value++ {
+		expected := "หนึ่งร้อยบาทถ้วน"
+		if value < 100 {
+			expected = satangReadings[value] + "บาทถ้วน"
+		}
+
+		result, err := Convert(value)
+		if err != nil {
+			t.Errorf("Convert(%d) returned error: %v", value, err)
+			continue
+		}
+		if result != expected {
+			t.Errorf("Convert(%d) = %s, expected %s", value, result, expected)
+		}
+		if strings.Contains(result, "หนึ่งสิบ") {
+			t.Errorf("Convert(%d) = %s, must not contain หนึ่งสิบ", value, result)
+		}
+	}
+}
+
+// TestTensTwoInEveryGroup checks that a 2 in the tens place reads "ยี่สิบ"
+// in every 6-digit group, while a 2 in other places stays "สอง"
+func TestTensTwoInEveryGroup(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"20", "ยี่สิบบาทถ้วน"},
+		{"20,000,000", "ยี่สิบล้านบาทถ้วน"},
+		{"21,000,000", "ยี่สิบเอ็ดล้านบาทถ้วน"},
+		{"1,020,000", "หนึ่งล้านสองหมื่นบาทถ้วน"},
+		{"1,000,020", "หนึ่งล้านยี่สิบบาทถ้วน"},
+		{"20,020,000,000", "สองหมื่นยี่สิบล้านบาทถ้วน"},
+		{"20,000,000,000,000", "ยี่สิบล้านล้านบาทถ้วน"},
+		{"1,000,000,020", "หนึ่งพันล้านยี่สิบบาทถ้วน"},
+		{"22,222,222", "ยี่สิบสองล้านสองแสนสองหมื่นสองพันสองร้อยยี่สิบสองบาทถ้วน"},
+		{"2,222,222,222,222,222,222", "สองล้านสองแสนสองหมื่นสองพันสองร้อยยี่สิบสองล้านสองแสนสองหมื่นสองพันสองร้อยยี่สิบสองล้านสองแสนสองหมื่นสองพันสองร้อยยี่สิบสองบาทถ้วน"},
+		{"0.22", "ศูนย์บาทยี่สิบสองสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, test.expected)
+		}
+		if strings.Contains(result, "สองสิบ") {
+			t.Errorf("Convert(%s) = %s, contains สองสิบ", test.input, result)
+		}
+	}
+}
+
+func TestMinorUnitDigits(t *testing.T) {
+	three := NewConverter(&Config{MinorUnitDigits: 3})
+	overflow := NewConverter(&Config{MinorUnitDigits: 3, AllowOverflow: true})
+
+	tests := []struct {
+		converter *Converter
+		input     any
+		expected  string
+	}{
+		{three, "1.123", "หนึ่งบาทหนึ่งร้อยยี่สิบสามสตางค์"},
+		{three, "1.001", "หนึ่งบาทหนึ่งสตางค์"},
+		{three, "1.021", "หนึ่งบาทยี่สิบเอ็ดสตางค์"},
+		{three, "1.101", "หนึ่งบาทหนึ่งร้อยเอ็ดสตางค์"},
+		{three, "1.5", "หนึ่งบาทห้าร้อยสตางค์"},
+		{three, "1.1235", "หนึ่งบาทหนึ่งร้อยยี่สิบสี่สตางค์"},
+		{three, "1.1234", "หนึ่งบาทหนึ่งร้อยยี่สิบสามสตางค์"},
+		{three, "7", "เจ็ดบาทถ้วน"},
+		{three, "7.000", "เจ็ดบาทถ้วน"},
+		{three, "7.9996", "เจ็ดบาทเก้าร้อยเก้าสิบเก้าสตางค์"},
+		{overflow, "7.9996", "แปดบาทถ้วน"},
+		{three, "-0.0004", "ศูนย์บาทถ้วน"},
+		{NewConverter(&Config{MinorUnitDigits: 2}), "1.125", "หนึ่งบาทสิบสามสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := test.converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) with 3 minor digits = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+
+	if result, _ := three.Convert("1.1234", RoundUp); result != "หนึ่งบาทหนึ่งร้อยยี่สิบสี่สตางค์" {
+		t.Errorf("Convert(1.1234, RoundUp) with 3 minor digits = %s", result)
+	}
+	if _, value, _ := three.ConvertWithValue("12"); value != "12.000" {
+		t.Errorf("ConvertWithValue(12) with 3 minor digits value = %s, expected 12.000", value)
+	}
+	fraction := NewConverter(&Config{MinorUnitDigits: 3, SatangAsFraction: true, FractionForEven: true})
+	for input, expected := range map[string]string{"5.05": "ห้าบาท 050/1000", "5": "ห้าบาท 000/1000"} {
+		if result, _ := fraction.Convert(input); result != expected {
+			t.Errorf("Convert(%s) as a 3-digit fraction = %s, expected %s", input, result, expected)
+		}
+	}
+
+	for _, config := range []*Config{{MinorUnitDigits: 7}, {MinorUnitDigits: -1}} {
+		if _, err := NewConverter(config).Convert("1"); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(1) with %+v error = %v, expected invalid input", config, err)
+		}
+	}
+}
+
+func TestMaxValue(t *testing.T) {
+	converter := NewConverter(&Config{MaxValue: "9,999,999.99"})
+
+	for _, input := range []any{"9999999.99", "-9999999.99", 5000000, "9999999.994", "0.5"} {
+		if _, err := converter.Convert(input); err != nil {
+			t.Errorf("Convert(%v) with MaxValue returned error: %v", input, err)
+		}
+	}
+
+	for _, input := range []any{"10000000", "-10000000", "10000000.001", 9223372036854775807} {
+		_, err := converter.Convert(input)
+		if !errors.Is(err, ErrExceedsMaxValue) {
+			t.Errorf("Convert(%v) with MaxValue error = %v, expected exceeds max value", input, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), "9999999.99") {
+			t.Errorf("Convert(%v) with MaxValue error = %v, expected the configured limit", input, err)
+		}
+	}
+
+	if _, err := converter.ConvertNumber("10000000.5"); !errors.Is(err, ErrExceedsMaxValue) {
+		t.Errorf("ConvertNumber(10000000.5) with MaxValue error = %v, expected exceeds max value", err)
+	}
+
+	// The package functions keep the package limit
+	if _, err := Convert("10000000"); err != nil {
+		t.Errorf("Convert(10000000) returned error: %v", err)
+	}
+
+	thai := NewConverter(&Config{MaxValue: "100", ErrorLanguage: ErrorLanguageThai})
+	if _, err := thai.Convert("101"); err == nil || !strings.Contains(err.Error(), "100") {
+		t.Errorf("Convert(101) with Thai errors = %v, expected the configured limit", err)
+	}
+
+	for _, limit := range []string{"abc", "-5", "1.2.3", "99999999999999999999"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewConverter with MaxValue %q expected panic", limit)
+				}
+			}()
+			NewConverter(&Config{MaxValue: limit})
+		}()
+	}
+}
+
+func TestSatangAsDecimal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"100.45", "หนึ่งร้อยบาทจุดสี่ห้า"},
+		{"100.00", "หนึ่งร้อยบาทถ้วน"},
+		{"100", "หนึ่งร้อยบาทถ้วน"},
+		{"0.05", "ศูนย์บาทจุดศูนย์ห้า"},
+		{"21.5", "ยี่สิบเอ็ดบาทจุดห้าศูนย์"},
+		{"-1.456", "ลบหนึ่งบาทจุดสี่หก"},
+	}
+
+	converter := NewConverter(&Config{SatangAsDecimal: true})
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with SatangAsDecimal = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestSatangStyle(t *testing.T) {
+	tests := []struct {
+		config   *Config
+		input    string
+		expected string
+	}{
+		{&Config{}, "100.50", "หนึ่งร้อยบาทห้าสิบสตางค์"},
+		{&Config{SatangStyle: SatangRemainder}, "100.50", "หนึ่งร้อยบาทเศษห้าสิบสตางค์"},
+		{&Config{SatangStyle: SatangRemainder}, "100", "หนึ่งร้อยบาทถ้วน"},
+		{&Config{SatangStyle: SatangRemainder, ZeroSatangStyle: ExplicitZeroSatang}, "100", "หนึ่งร้อยบาทเศษศูนย์สตางค์"},
+		{&Config{SatangStyle: SatangCustomConnector, SatangConnector: "กับ"}, "-0.21", "ลบศูนย์บาทกับยี่สิบเอ็ดสตางค์"},
+		{&Config{SatangStyle: SatangCustomConnector}, "1.05", "หนึ่งบาทห้าสตางค์"},
+		{&Config{SatangStyle: SatangCustomConnector, SatangConnector: "และ", CurrencyFirst: true}, "2.50", "บาท สอง และห้าสิบสตางค์"},
+		{&Config{SatangStyle: SatangRemainder, SatangAsDecimal: true}, "2.50", "สองบาทจุดห้าศูนย์"},
+		{&Config{SatangConnector: "กับ"}, "2.50", "สองบาทห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, err := NewConverter(test.config).Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with %+v = %s, expected %s", test.input, *test.config, result, test.expected)
+		}
+	}
+}
+
+func TestSatangAsFraction(t *testing.T) {
+	tests := []struct {
+		input           string
+		fractionForEven bool
+		expected        string
+	}{
+		{"100.45", false, "หนึ่งร้อยบาท 45/100"},
+		{"100.45", true, "หนึ่งร้อยบาท 45/100"},
+		{"100.00", false, "หนึ่งร้อยบาทถ้วน"},
+		{"100.00", true, "หนึ่งร้อยบาท 00/100"},
+		{"100", true, "หนึ่งร้อยบาท 00/100"},
+		{"0.05", false, "ศูนย์บาท 05/100"},
+		{"1,234.567", false, "หนึ่งพันสองร้อยสามสิบสี่บาท 57/100"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{SatangAsFraction: true, FractionForEven: test.fractionForEven})
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with SatangAsFraction = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestThaiDigits(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"๑๒๓.๔๕", "123.45"},
+		{"๐๑๒๓๔๕๖๗๘๙", "0123456789"},
+		{"๑,๒๓๔,๕๖๗.๘๙", "1,234,567.89"},
+		{"1๒3.4๕", "123.45"},
+		{"-๕๐", "-50"},
+		{"๙๒๒๓๓๗๒๐๓๖๘๕๔๗๗๕๘๐๗", "9223372036854775807"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		expected, _ := Convert(test.expected)
+		if result != expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, expected)
+		}
+	}
+
+	// Digits from other scripts are still rejected
+	if _, err := Convert("١٢٣"); err == nil {
+		t.Errorf("Convert(١٢٣) expected error for Arabic-Indic digits, got nil")
+	}
+}
+
+func TestExplicitZeroSatangInputTypes(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{100, "หนึ่งร้อยบาทศูนย์สตางค์"},
+		{int64(100), "หนึ่งร้อยบาทศูนย์สตางค์"},
+		{int64(-21), "ลบยี่สิบเอ็ดบาทศูนย์สตางค์"},
+		{uint8(0), "ศูนย์บาทศูนย์สตางค์"},
+		{"100", "หนึ่งร้อยบาทศูนย์สตางค์"},
+		{"100.00", "หนึ่งร้อยบาทศูนย์สตางค์"},
+		{100.5, "หนึ่งร้อยบาทห้าสิบสตางค์"},
+	}
+
+	converter := NewConverter(&Config{ZeroSatangStyle: ExplicitZeroSatang})
+	for _, test := range tests {
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) with ExplicitZeroSatang = %s, expected %s", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestZeroSatangStyle(t *testing.T) {
+	tests := []struct {
+		style    ZeroSatangStyle
+		input    any
+		expected string
+	}{
+		{SuffixThuan, "100", "หนึ่งร้อยบาทถ้วน"},
+		{SuffixThuan, "100.00", "หนึ่งร้อยบาทถ้วน"},
+		{SuffixThuan, "100.995", "หนึ่งร้อยเอ็ดบาทถ้วน"},
+		{SuffixThuan, "100.50", "หนึ่งร้อยบาทห้าสิบสตางค์"},
+		{ExplicitZeroSatang, "100", "หนึ่งร้อยบาทศูนย์สตางค์"},
+		{ExplicitZeroSatang, "100.00", "หนึ่งร้อยบาทศูนย์สตางค์"},
+		{ExplicitZeroSatang, "100.995", "หนึ่งร้อยเอ็ดบาทศูนย์สตางค์"},
+		{ExplicitZeroSatang, "-0.999", "ลบหนึ่งบาทศูนย์สตางค์"},
+		{ExplicitZeroSatang, "100.50", "หนึ่งร้อยบาทห้าสิบสตางค์"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{ZeroSatangStyle: test.style, AllowOverflow: true})
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%v) with ZeroSatangStyle %d = %s, expected %s", test.input, test.style, result, test.expected)
+		}
+	}
+}
+
+func TestScientificNotation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.5e6", "1500000"},
+		{"1.5E6", "1500000"},
+		{"1e+3", "1000"},
+		{"2.3E-2", "0.023"},
+		{"-1.25e2", "-125"},
+		{"12345e-2", "123.45"},
+		{"1.23456e2", "123.456"},
+		{"9.223372036854775807e18", "9223372036854775807"}, // exact, no float precision loss
+		{"5e-3", "0.005"},
+		{"4.9e-3", "0.0049"},
+		{"1e-50", "0"},
+		{"๑.๕e๖", "1500000"},
+	}
+
+	for _, test := range tests {
+		result, err := Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		expected, _ := Convert(test.expected)
+		if result != expected {
+			t.Errorf("Convert(%s) = %s, expected %s", test.input, result, expected)
+		}
+	}
+
+	for _, input := range []string{"1e", "e5", "1.5e6.2", "1e5e5", "1e99999", "1.2.3e4", "1e20"} {
+		if _, err := Convert(input); err == nil {
+			t.Errorf("Convert(%s) expected error, got nil", input)
+		}
+	}
+}
+
+func TestMillionRepeatSeparator(t *testing.T) {
+	tests := []struct {
+		input     string
+		separator string
+		expected  string
+	}{
+		{"1000000000000", " ", "หนึ่งล้าน ล้านบาทถ้วน"},
+		{"1000000000000000000", " ", "หนึ่งล้าน ล้าน ล้านบาทถ้วน"},
+		{"1000000000000000000", "-", "หนึ่งล้าน-ล้าน-ล้านบาทถ้วน"},
+		{"1000000000000", "", "หนึ่งล้านล้านบาทถ้วน"},
+		{"1000000", " ", "หนึ่งล้านบาทถ้วน"},
+		{"1000000000001", " ", "หนึ่งล้าน ล้านเอ็ดบาทถ้วน"},
+		{"1000001000000", " ", "หนึ่งล้านเอ็ดล้านบาทถ้วน"},
+	}
+
+	for _, test := range tests {
+		converter := NewConverter(&Config{MillionRepeatSeparator: test.separator})
+		result, err := converter.Convert(test.input)
+		if err != nil {
+			t.Errorf("Convert(%s) returned error: %v", test.input, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("Convert(%s) with separator %q = %s, expected %s", test.input, test.separator, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package thbtextizer
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ConvertTokens converts amount like Convert but returns the reading split
+// into its words, such as ["หนึ่ง", "ร้อย", "ยี่", "สิบ", "เอ็ด", "บาท", "ถ้วน"].
+// Whitespace only separates tokens, and text the converter does not know,
+// such as a custom Suffix, is kept as whole tokens.
+func ConvertTokens(amount any, roundingMode ...DecimalRoundingMode) ([]string, error) {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	opts := globalOptions()
+	text, err := convertWithMode(amount, mode, opts)
+	if err != nil {
+		return nil, err
+	}
+	return tokenize(text, vocabulary(opts)), nil
+}
+
+// ConvertTokens converts amount to reading words using instance configuration
+func (c *Converter) ConvertTokens(amount any, roundingMode ...DecimalRoundingMode) ([]string, error) {
+	opts := c.config.options()
+	text, err := c.convertWithOptions(amount, roundingMode, opts)
+	if err != nil {
+		return nil, err
+	}
+	return tokenize(text, vocabulary(opts)), nil
+}
+
+// vocabulary lists every word a reading under opts can contain, longest first
+func vocabulary(opts convertOptions) []string {
+	words := []string{"ศูนย์", "เอ็ด", "ยี่", "ลบ", "จุด"}
+	for _, name := range digitNames[1:] {
+		words = append(words, name)
+	}
+	for _, name := range unitNames {
+		if name != "" {
+			words = append(words, name)
+		}
+	}
+	// Words from a custom Lexicon or DigitWords and UnitWords
+	if opts.lexicon != nil {
+		lex := opts.lexicon
+		for digit := range 10 {
+			words = append(words, lex.Digit(digit))
+		}
+		for place := 1; place <= 6; place++ {
+			words = append(words, lex.Unit(place))
+		}
+		words = append(words, lex.TrailingOne(), lex.Point(), lex.Minus(), lex.Plus(), lex.Remainder())
+	}
+	units := opts.units()
+	for _, word := range []string{units.Unit, units.SubUnit, units.Even, opts.negativePrefix} {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	return words
+}
+
+// tokenize splits text into the longest vocabulary words, gathering any
+// unknown characters between them into a single token
+func tokenize(text string, words []string) []string {
+	var tokens []string
+	unknownStart := -1
+	flushUnknown := func(end int) {
+		if unknownStart >= 0 {
+			tokens = append(tokens, text[unknownStart:end])
+			unknownStart = -1
+		}
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if unicode.IsSpace(r) {
+			flushUnknown(i)
+			i += size
+			continue
+		}
+
+		matched := ""
+		for _, word := range words {
+			if strings.HasPrefix(text[i:], word) {
+				matched = word
+				break
+			}
+		}
+		if matched == "" {
+			if unknownStart < 0 {
+				unknownStart = i
+			}
+			i += size
+			continue
+		}
+
+		flushUnknown(i)
+		tokens = append(tokens, matched)
+		i += len(matched)
+	}
+	flushUnknown(len(text))
+
+	return tokens
+}
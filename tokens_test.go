@@ -0,0 +1,38 @@
+package thbtextizer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConvertTokens(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected []string
+	}{
+		{"121", []string{"หนึ่ง", "ร้อย", "ยี่", "สิบ", "เอ็ด", "บาท", "ถ้วน"}},
+		{"0.05", []string{"ศูนย์", "บาท", "ห้า", "สตางค์"}},
+		{"-1000000", []string{"ลบ", "หนึ่ง", "ล้าน", "บาท", "ถ้วน"}},
+	}
+
+	for _, test := range tests {
+		result, err := ConvertTokens(test.input)
+		if err != nil {
+			t.Errorf("ConvertTokens(%v) returned error: %v", test.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ConvertTokens(%v) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+
+	converter := NewConverter(&Config{SpaceBeforeMillion: true, MajorUnit: "ดอลลาร์", Suffix: " (โดยประมาณ)"})
+	result, err := converter.ConvertTokens("2000000")
+	if err != nil {
+		t.Fatalf("ConvertTokens(2000000) returned error: %v", err)
+	}
+	expected := []string{"สอง", "ล้าน", "ดอลลาร์", "ถ้วน", "(โดยประมาณ)"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ConvertTokens(2000000) = %q, expected %q", result, expected)
+	}
+}
@@ -0,0 +1,30 @@
+package thbtextizer
+
+import "strconv"
+
+// ConvertString converts a string amount with a flat signature that binds
+// easily across a WebAssembly or other FFI boundary, such as with
+// syscall/js. It is a thin wrapper over Convert that returns the error
+// message instead of an error; the message is empty on success.
+//
+// mode is the integer value of a DecimalRoundingMode:
+//
+//	0 RoundHalf
+//	1 RoundDown
+//	2 RoundUp
+//	3 RoundCeil
+//	4 RoundFloor
+//	5 RoundHalfUp
+//
+// Any other mode returns an error message rather than a reading.
+func ConvertString(input string, mode int) (string, string) {
+	if mode < int(RoundHalf) || mode > int(RoundHalfUp) {
+		return "", newInvalidInputError(strconv.Itoa(mode), reasonUnknownModeNumber).Error()
+	}
+
+	result, err := Convert(input, DecimalRoundingMode(mode))
+	if err != nil {
+		return "", err.Error()
+	}
+	return result, ""
+}
@@ -0,0 +1,45 @@
+package thbtextizer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConvertString(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     int
+		expected string
+	}{
+		{"123.456", 0, "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"},
+		{"123.456", 1, "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์"},
+		{"123.451", 2, "หนึ่งร้อยยี่สิบสามบาทสี่สิบหกสตางค์"},
+		{"-1.234", 3, "ลบหนึ่งบาทยี่สิบสามสตางค์"},
+		{"-1.234", 4, "ลบหนึ่งบาทยี่สิบสี่สตางค์"},
+		{"-1.235", 5, "ลบหนึ่งบาทยี่สิบสามสตางค์"},
+	}
+
+	for _, test := range tests {
+		result, message := ConvertString(test.input, test.mode)
+		if message != "" {
+			t.Errorf("ConvertString(%s, %d) returned error message: %s", test.input, test.mode, message)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ConvertString(%s, %d) = %s, expected %s", test.input, test.mode, result, test.expected)
+		}
+		if converted, _ := Convert(test.input, DecimalRoundingMode(test.mode)); converted != result {
+			t.Errorf("ConvertString(%s, %d) = %s differs from Convert %s", test.input, test.mode, result, converted)
+		}
+	}
+
+	if result, message := ConvertString("1.2.3", 0); result != "" || message == "" {
+		t.Errorf("ConvertString(1.2.3, 0) = %q, %q, expected an error message", result, message)
+	}
+
+	for _, mode := range []int{-1, 6} {
+		if result, message := ConvertString("1", mode); result != "" || !strings.Contains(message, "unknown rounding mode") {
+			t.Errorf("ConvertString(1, %d) = %q, %q, expected an unknown rounding mode message", mode, result, message)
+		}
+	}
+}
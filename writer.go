@@ -0,0 +1,62 @@
+package thbtextizer
+
+import (
+	"io"
+)
+
+// ConvertTo converts amount like Convert but writes the reading straight to
+// w, so report generators can stream many amounts into a file or HTTP
+// response without building an intermediate string for each one. Writers
+// that implement io.StringWriter avoid a copy per word.
+func ConvertTo(w io.Writer, amount any, roundingMode ...DecimalRoundingMode) error {
+	mode := RoundHalf
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertTo(w, amount, mode, globalOptions())
+}
+
+// ConvertTo writes the reading of amount to w using instance configuration
+func (c *Converter) ConvertTo(w io.Writer, amount any, roundingMode ...DecimalRoundingMode) error {
+	mode := c.config.DefaultRounding
+	if len(roundingMode) > 0 {
+		mode = roundingMode[0]
+	}
+
+	return convertTo(w, amount, mode, c.config.options())
+}
+
+func convertTo(w io.Writer, amount any, mode DecimalRoundingMode, opts convertOptions) error {
+	normalized, err := prepareAmount(amount, mode, opts)
+	if err != nil {
+		return err
+	}
+
+	return writeReading(w, readAmount(normalized, opts), opts.units(), opts)
+}
+
+// errWriter writes strings to w until the first error, which it keeps so a
+// sequence of writes only needs one check at the end
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (e *errWriter) writeString(s string) {
+	if e.err != nil || s == "" {
+		return
+	}
+	_, e.err = io.WriteString(e.w, s)
+}
+
+func (e *errWriter) writeByte(c byte) {
+	if e.err != nil {
+		return
+	}
+	if bw, ok := e.w.(io.ByteWriter); ok {
+		e.err = bw.WriteByte(c)
+		return
+	}
+	_, e.err = e.w.Write([]byte{c})
+}
@@ -0,0 +1,65 @@
+package thbtextizer
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// failingWriter fails every write with err
+type failingWriter struct {
+	err error
+}
+
+func (f failingWriter) Write(p []byte) (int, error) {
+	return 0, f.err
+}
+
+func TestConvertTo(t *testing.T) {
+	// Disable warning logs for cleaner test output
+	originalLogSetting := EnableWarningLogs
+	defer func() { EnableWarningLogs = originalLogSetting }()
+	SetWarningLogs(false)
+
+	inputs := []any{"123.45", 100, "-0.5", "9223372036854775807", 1.995}
+
+	for _, input := range inputs {
+		var buf bytes.Buffer
+		if err := ConvertTo(&buf, input); err != nil {
+			t.Errorf("ConvertTo(%v) returned error: %v", input, err)
+			continue
+		}
+		expected, _ := Convert(input)
+		if buf.String() != expected {
+			t.Errorf("ConvertTo(%v) wrote %s, expected %s", input, buf.String(), expected)
+		}
+	}
+
+	// Several amounts can be streamed into the same writer
+	var buf bytes.Buffer
+	converter := NewConverter(&Config{CurrencyFirst: true, SatangFirst: true})
+	for _, input := range []string{"1.5", "2"} {
+		if err := converter.ConvertTo(&buf, input); err != nil {
+			t.Fatalf("ConvertTo(%s) returned error: %v", input, err)
+		}
+		buf.WriteByte('\n')
+	}
+	if expected := "บาท หนึ่ง สตางค์ ห้าสิบ\nบาท สองถ้วน\n"; buf.String() != expected {
+		t.Errorf("ConvertTo wrote %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestConvertToErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ConvertTo(&buf, "abc"); err == nil {
+		t.Errorf("ConvertTo(abc) expected error, got nil")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("ConvertTo(abc) wrote %q, expected nothing", buf.String())
+	}
+
+	writeErr := errors.New("disk full")
+	if err := ConvertTo(failingWriter{err: writeErr}, "123.45"); !errors.Is(err, writeErr) {
+		t.Errorf("ConvertTo with failing writer returned %v, expected %v", err, writeErr)
+	}
+}